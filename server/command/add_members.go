@@ -84,7 +84,7 @@ func executeAddMembers(args []string, context Context) (*model.CommandResponse,
 	addedUsers, notAddedUsers := addChannelMembers(userIds, context.CommandArgs.ChannelId)
 
 	// adding successfully invited members to channel's standup config
-	if err := addStandupMembers(addedUsers, context.CommandArgs.ChannelId); err != nil {
+	if err := addStandupMembers(addedUsers, context.CommandArgs.ChannelId, getStandupID(context), context.CommandArgs.UserId); err != nil {
 		return util.SendEphemeralText("Error occurred while adding standup members.")
 	}
 
@@ -115,8 +115,8 @@ func addChannelMembers(userIds []string, channelID string) ([]string, []string)
 	return addedUsers, notAddedUsers
 }
 
-func addStandupMembers(usernames []string, channelID string) error {
-	standupConfig, err := standup.GetStandupConfig(channelID)
+func addStandupMembers(usernames []string, channelID, standupID, userID string) error {
+	standupConfig, err := standup.GetStandupConfig(channelID, standupID)
 	if err != nil {
 		return err
 	}
@@ -126,7 +126,7 @@ func addStandupMembers(usernames []string, channelID string) error {
 	}
 
 	standupConfig.Members = append(standupConfig.Members, usernames...)
-	_, err = standup.SaveStandupConfig(standupConfig)
+	_, err = standup.SaveStandupConfig(standupConfig, userID)
 	if err != nil {
 		return err
 	}
@@ -1,33 +1,147 @@
 package command
 
 import (
+	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/thoas/go-funk"
 
 	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/logger"
+	"github.com/standup-raven/standup-raven/server/standup"
+	"github.com/standup-raven/standup-raven/server/util"
+)
+
+const (
+	configSubCommandHistory = "history"
+	configSubCommandRestore = "restore"
 )
 
 func commandConfig() *Config {
 	return &Config{
 		AutocompleteData: &model.AutocompleteData{
 			Trigger:  "config",
+			Hint:     "[history | restore <version>]",
 			HelpText: "Open channel standup configuration dialog.",
 			RoleID:   model.SYSTEM_USER_ROLE_ID,
+			SubCommands: []*model.AutocompleteData{
+				{
+					Trigger:  configSubCommandHistory,
+					HelpText: "List previous versions of channel standup configuration.",
+					RoleID:   model.SYSTEM_USER_ROLE_ID,
+				},
+				{
+					Trigger:  configSubCommandRestore,
+					Hint:     "[version]",
+					HelpText: "Restore a previous version of channel standup configuration.",
+					RoleID:   model.SYSTEM_USER_ROLE_ID,
+					Arguments: []*model.AutocompleteArg{
+						{
+							Type:     model.AutocompleteArgTypeText,
+							Required: true,
+							HelpText: "Version number as listed by `/standup config history`",
+							Data: &model.AutocompleteTextArg{
+								Hint:    "Version",
+								Pattern: "\\d+",
+							},
+						},
+					},
+				},
+			},
 		},
-		ExtraHelpText: "",
-		Validate:      validateCommandConfig,
-		Execute:       executeCommandConfig,
+		ExtraHelpText: "* `config history` lists previous versions of channel standup configuration\n" +
+			"* `config restore <version>` restores the specified version",
+		Validate: validateCommandConfig,
+		Execute:  executeCommandConfig,
 	}
 }
 
 func validateCommandConfig(args []string, context Context) (*model.CommandResponse, *model.AppError) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+
+	switch args[0] {
+	case configSubCommandHistory:
+		return nil, nil
+	case configSubCommandRestore:
+		if len(args) < 2 {
+			return util.SendEphemeralText("Please specify the version to restore.")
+		}
+
+		version, err := strconv.Atoi(args[1])
+		if err != nil || version < 1 {
+			return util.SendEphemeralText("Invalid version specified: " + args[1])
+		}
+
+		if response, appErr := validateConfigPermission(context); response != nil || appErr != nil {
+			return response, appErr
+		}
+
+		context.Props["version"] = version
+		return nil, nil
+	default:
+		return util.SendEphemeralText("Invalid sub-command: " + args[0])
+	}
+}
+
+// validateConfigPermission verifies the user is allowed to
+// update channel standup configuration, same as the HTTP API does.
+func validateConfigPermission(context Context) (*model.CommandResponse, *model.AppError) {
+	userRoles, appErr := util.GetUserRoles(context.CommandArgs.UserId, context.CommandArgs.ChannelId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if funk.Contains(userRoles, model.SYSTEM_GUEST_ROLE_ID) {
+		return util.SendEphemeralText("Guest users are not allowed to perform this operation.")
+	}
+
+	if config.GetConfig().PermissionSchemaEnabled && !isAdmin(userRoles) {
+		return util.SendEphemeralText("You do not have permission to perform this operation.")
+	}
+
+	return nil, nil
+}
+
+// validateAdminPermission verifies the user is a channel, team or system admin,
+// irrespective of the permission schema setting.
+func validateAdminPermission(context Context) (*model.CommandResponse, *model.AppError) {
+	userRoles, appErr := util.GetUserRoles(context.CommandArgs.UserId, context.CommandArgs.ChannelId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if !isAdmin(userRoles) {
+		return util.SendEphemeralText("You do not have permission to perform this operation.")
+	}
+
 	return nil, nil
 }
 
+func isAdmin(userRoles []string) bool {
+	return funk.Contains(userRoles, model.SYSTEM_ADMIN_ROLE_ID) ||
+		funk.Contains(userRoles, model.TEAM_ADMIN_ROLE_ID) ||
+		funk.Contains(userRoles, model.CHANNEL_ADMIN_ROLE_ID)
+}
+
 func executeCommandConfig(args []string, context Context) (*model.CommandResponse, *model.AppError) {
+	if len(args) > 0 {
+		switch args[0] {
+		case configSubCommandHistory:
+			return executeCommandConfigHistory(context)
+		case configSubCommandRestore:
+			return executeCommandConfigRestore(context)
+		}
+	}
+
 	config.Mattermost.PublishWebSocketEvent(
 		"open_config_modal",
 		map[string]interface{}{
 			"channel_id": context.CommandArgs.ChannelId,
+			"standup_id": getStandupID(context),
 		},
 		&model.WebsocketBroadcast{
 			UserId: context.CommandArgs.UserId,
@@ -39,3 +153,74 @@ func executeCommandConfig(args []string, context Context) (*model.CommandRespons
 		Text:         "Configure your standup in the open modal!", // TODO: update this message to something more elegant
 	}, nil
 }
+
+func executeCommandConfigHistory(context Context) (*model.CommandResponse, *model.AppError) {
+	history, err := standup.GetStandupConfigHistory(context.CommandArgs.ChannelId, getStandupID(context))
+	if err != nil {
+		return util.SendEphemeralText("Error occurred while fetching standup configuration history.")
+	}
+
+	if len(history) == 0 {
+		return util.SendEphemeralText("No previous versions of standup configuration found for this channel.")
+	}
+
+	text := "#### Standup Configuration History\n\n" +
+		"| Version | Saved By | Saved At | Status | Sections | Members |\n" +
+		"|:--------|:---------|:---------|:-------|:---------|:--------|\n"
+
+	// latest version first
+	for i := len(history) - 1; i >= 0; i-- {
+		version := history[i]
+
+		savedBy := "System"
+		if version.UserID != "" {
+			user, appErr := config.Mattermost.GetUser(version.UserID)
+			if appErr != nil {
+				logger.Error("Couldn't fetch user", appErr, map[string]interface{}{"userID": version.UserID})
+				savedBy = version.UserID
+			} else {
+				savedBy = "@" + user.Username
+			}
+		}
+
+		savedAt := version.CreatedAt
+		if location, err := time.LoadLocation(version.Config.Timezone); err == nil {
+			savedAt = savedAt.In(location)
+		}
+
+		status := "Disabled"
+		if version.Config.Enabled {
+			status = "Enabled"
+		}
+
+		text += fmt.Sprintf(
+			"| %d | %s | %s | %s | %d | %d |\n",
+			version.Version,
+			savedBy,
+			savedAt.Format("2 Jan 2006 15:04 MST"),
+			status,
+			len(version.Config.Sections),
+			len(version.Config.Members),
+		)
+	}
+
+	restoreCommand := "/standup config restore <version>"
+	if standupID := getStandupID(context); standupID != standup.DefaultStandupID {
+		restoreCommand += " " + standupFlag + " " + standupID
+	}
+
+	text += "\nRun `" + restoreCommand + "` to restore a version."
+	return util.SendEphemeralText(text)
+}
+
+func executeCommandConfigRestore(context Context) (*model.CommandResponse, *model.AppError) {
+	version := context.Props["version"].(int)
+
+	standupConfig, err := standup.RestoreStandupConfigVersion(context.CommandArgs.ChannelId, getStandupID(context), version, context.CommandArgs.UserId)
+	if err != nil {
+		return util.SendEphemeralText("Couldn't restore standup configuration. " + err.Error())
+	}
+
+	standup.PublishActiveChannelEvent(standupConfig.ChannelID, context.CommandArgs.UserId)
+	return util.SendEphemeralText(fmt.Sprintf("Standup configuration version %d restored successfully.", version))
+}
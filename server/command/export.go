@@ -0,0 +1,183 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/thoas/go-funk"
+
+	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/logger"
+	"github.com/standup-raven/standup-raven/server/otime"
+	"github.com/standup-raven/standup-raven/server/standup"
+	"github.com/standup-raven/standup-raven/server/util"
+)
+
+func commandExport() *Config {
+	return &Config{
+		AutocompleteData: &model.AutocompleteData{
+			Trigger:  "export",
+			Hint:     "[from] [to] [csv | json]",
+			HelpText: "Export standups of the current channel submitted between the provided dates.",
+			RoleID:   model.SYSTEM_USER_ROLE_ID,
+			Arguments: []*model.AutocompleteArg{
+				{
+					HelpText: "Start date in `DD-MM-YYYY` format",
+					Type:     model.AutocompleteArgTypeText,
+					Required: true,
+					Data: &model.AutocompleteTextArg{
+						Hint:    "From",
+						Pattern: "\\d\\d-\\d\\d-\\d\\d\\d\\d",
+					},
+				},
+				{
+					HelpText: "End date in `DD-MM-YYYY` format",
+					Type:     model.AutocompleteArgTypeText,
+					Required: true,
+					Data: &model.AutocompleteTextArg{
+						Hint:    "To",
+						Pattern: "\\d\\d-\\d\\d-\\d\\d\\d\\d",
+					},
+				},
+				{
+					HelpText: "Export format",
+					Type:     model.AutocompleteArgTypeStaticList,
+					Required: false,
+					Data: model.AutocompleteStaticListArg{
+						PossibleArguments: []model.AutocompleteListItem{
+							{
+								Item:     standup.ExportFormatCSV,
+								HelpText: "One row per task with date, user, section and text columns.",
+							},
+							{
+								Item:     standup.ExportFormatJSON,
+								HelpText: "List of tasks with date, user, section and text fields.",
+							},
+						},
+					},
+				},
+			},
+		},
+		ExtraHelpText: "* dates must be in `DD-MM-YYYY` format\n" +
+			fmt.Sprintf("* dates can be at most %d days apart\n", standup.StandupHistoryMaxDays) +
+			"* format can be `csv` or `json`. Defaults to `csv`\n" +
+			"* exported file is sent to you as a direct message",
+		Validate: validateCommandExport,
+		Execute:  executeCommandExport,
+	}
+}
+
+func validateCommandExport(args []string, context Context) (*model.CommandResponse, *model.AppError) {
+	if len(args) < 2 || len(args) > 3 {
+		return util.SendEphemeralText("Please specify the dates to export standups for.")
+	}
+
+	standupConfig, err := standup.GetStandupConfig(context.CommandArgs.ChannelId, getStandupID(context))
+	if err != nil {
+		return util.SendEphemeralText("Error getting standup config of the channel")
+	}
+
+	if standupConfig == nil {
+		return util.SendEphemeralText("Standup not configured for the channel")
+	}
+
+	location, err := time.LoadLocation(standupConfig.Timezone)
+	if err != nil {
+		return util.SendEphemeralText("Error loading timezone of the channel standup")
+	}
+
+	dates := make([]otime.OTime, 2)
+	for i, arg := range args[:2] {
+		t, err := time.ParseInLocation(dateLayout, arg, location)
+		if err != nil {
+			return util.SendEphemeralText(fmt.Sprintf("Error parsing this date: %s. Please specify date in format: DD-MM-YYYY", arg))
+		}
+
+		dates[i] = otime.OTime{Time: t}
+	}
+
+	if err := standup.ValidateStandupHistoryRange(dates[0], dates[1]); err != nil {
+		return util.SendEphemeralText("Invalid dates specified. " + err.Error())
+	}
+
+	format := standup.ExportFormatCSV
+	if len(args) == 3 {
+		format = strings.ToLower(args[2])
+	}
+
+	if !funk.ContainsString(standup.ExportFormats, format) {
+		return util.SendEphemeralText("Invalid export format: " + args[2] + ". Format can be one of: " + strings.Join(standup.ExportFormats, ", "))
+	}
+
+	context.Props["from"] = dates[0]
+	context.Props["to"] = dates[1]
+	context.Props["format"] = format
+	return nil, nil
+}
+
+func executeCommandExport(args []string, context Context) (*model.CommandResponse, *model.AppError) {
+	from := context.Props["from"].(otime.OTime)
+	to := context.Props["to"].(otime.OTime)
+	format := context.Props["format"].(string)
+
+	if err := sendStandupExport(context.CommandArgs.ChannelId, getStandupID(context), context.CommandArgs.UserId, from, to, format); err != nil {
+		return util.SendEphemeralText("Error occurred while exporting standups.")
+	}
+
+	return util.SendEphemeralText("Standup export has been sent to you as a direct message.")
+}
+
+// sendStandupExport sends the exported standups file to the user from the bot.
+func sendStandupExport(channelID, standupID, userID string, from, to otime.OTime, format string) error {
+	rows, err := standup.GetStandupExport(channelID, standupID, from, to)
+	if err != nil {
+		return err
+	}
+
+	data, err := standup.EncodeStandupExport(rows, format)
+	if err != nil {
+		logger.Error("Couldn't encode standup export", err, map[string]interface{}{"channelID": channelID, "format": format})
+		return err
+	}
+
+	channel, appErr := config.Mattermost.GetChannel(channelID)
+	if appErr != nil {
+		logger.Error("Couldn't fetch channel", appErr, map[string]interface{}{"channelID": channelID})
+		return errors.New(appErr.Error())
+	}
+
+	botUserID := config.GetConfig().BotUserID
+	directChannel, appErr := config.Mattermost.GetDirectChannel(userID, botUserID)
+	if appErr != nil {
+		logger.Error("Couldn't fetch direct channel with user", appErr, map[string]interface{}{"userID": userID})
+		return errors.New(appErr.Error())
+	}
+
+	fileInfo, appErr := config.Mattermost.UploadFile(data, directChannel.Id, standup.GetStandupExportFileName(channel.Name, standupID, from, to, format))
+	if appErr != nil {
+		logger.Error("Couldn't upload standup export", appErr, map[string]interface{}{"channelID": channelID})
+		return errors.New(appErr.Error())
+	}
+
+	post := &model.Post{
+		UserId:    botUserID,
+		ChannelId: directChannel.Id,
+		Message: fmt.Sprintf(
+			"Here are the standups of ~%s from %s to %s.",
+			channel.Name,
+			from.Format(otime.LayoutISODate),
+			to.Format(otime.LayoutISODate),
+		),
+		FileIds: []string{fileInfo.Id},
+	}
+
+	if _, appErr := config.Mattermost.CreatePost(post); appErr != nil {
+		logger.Error("Couldn't send standup export", appErr, map[string]interface{}{"channelID": channelID, "userID": userID})
+		return errors.New(appErr.Error())
+	}
+
+	return nil
+}
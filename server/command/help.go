@@ -26,6 +26,13 @@ func executeCommandHelp(args []string, context Context) (*model.CommandResponse,
 		commandAddMembers(),
 		commandRemoveMembers(),
 		commandStandup(),
+		commandRestoreArchived(),
+		commandExport(),
+		commandSkip(),
+		commandUnskip(),
+		commandOutOfOffice(),
+		commandNext(),
+		commandReportTo(),
 		commandHelp(),
 	})
 
@@ -5,8 +5,14 @@ import (
 	"strings"
 
 	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/standup-raven/standup-raven/server/standup"
 )
 
+// standupFlag selects which of the channel's standups a command operates on.
+// Commands operate on the default standup when it's not specified.
+const standupFlag = "--standup"
+
 type Context struct {
 	CommandArgs *model.CommandArgs
 	Props       map[string]interface{}
@@ -39,13 +45,56 @@ func (c *Config) GetHelpText() string {
 	return helpText
 }
 
+// extractStandupID removes the standup flag and its value from command arguments,
+// returning the remaining arguments and the specified standup ID.
+func extractStandupID(args []string) ([]string, string, error) {
+	remainingArgs := []string{}
+	standupID := standup.DefaultStandupID
+
+	for i := 0; i < len(args); i++ {
+		if args[i] != standupFlag {
+			remainingArgs = append(remainingArgs, args[i])
+			continue
+		}
+
+		if i+1 >= len(args) {
+			return nil, "", fmt.Errorf("please specify the standup ID after %s", standupFlag)
+		}
+
+		standupID = args[i+1]
+		if err := standup.ValidateStandupID(standupID); err != nil {
+			return nil, "", err
+		}
+		i++
+	}
+
+	return remainingArgs, standupID, nil
+}
+
+// getStandupID returns the standup ID specified in the command,
+// falling back to the default standup.
+func getStandupID(context Context) string {
+	if standupID, ok := context.Props["standupID"].(string); ok {
+		return standupID
+	}
+
+	return standup.DefaultStandupID
+}
+
 // Remember to add any new command to `executeCommandHelp` as well for
 // generating help text.
 // executeCommandHelp doesn't use this map to prevent circular imports.
 var commands = map[string]*Config{
-	commandConfig().AutocompleteData.Trigger:        commandConfig(),
-	commandAddMembers().AutocompleteData.Trigger:    commandAddMembers(),
-	commandRemoveMembers().AutocompleteData.Trigger: commandRemoveMembers(),
-	commandStandup().AutocompleteData.Trigger:       commandStandup(),
-	commandHelp().AutocompleteData.Trigger:          commandHelp(),
+	commandConfig().AutocompleteData.Trigger:          commandConfig(),
+	commandAddMembers().AutocompleteData.Trigger:      commandAddMembers(),
+	commandRemoveMembers().AutocompleteData.Trigger:   commandRemoveMembers(),
+	commandStandup().AutocompleteData.Trigger:         commandStandup(),
+	commandRestoreArchived().AutocompleteData.Trigger: commandRestoreArchived(),
+	commandExport().AutocompleteData.Trigger:          commandExport(),
+	commandSkip().AutocompleteData.Trigger:            commandSkip(),
+	commandUnskip().AutocompleteData.Trigger:          commandUnskip(),
+	commandOutOfOffice().AutocompleteData.Trigger:     commandOutOfOffice(),
+	commandNext().AutocompleteData.Trigger:            commandNext(),
+	commandReportTo().AutocompleteData.Trigger:        commandReportTo(),
+	commandHelp().AutocompleteData.Trigger:            commandHelp(),
 }
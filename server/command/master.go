@@ -42,6 +42,12 @@ func getAvailableCommands() []string {
 }
 
 func validateCommandMaster(args []string, context Context) (*model.CommandResponse, *model.AppError) {
+	args, standupID, err := extractStandupID(args)
+	if err != nil {
+		return util.SendEphemeralText("Invalid standup specified. " + err.Error())
+	}
+	context.Props["standupID"] = standupID
+
 	if len(args) > 0 {
 		subCommand := args[0]
 		subCommandCommand, ok := commands[subCommand]
@@ -78,6 +84,7 @@ func executeCommandMaster(args []string, context Context) (*model.CommandRespons
 			"open_standup_modal",
 			map[string]interface{}{
 				"channel_id": context.CommandArgs.ChannelId,
+				"standup_id": getStandupID(context),
 			},
 			&model.WebsocketBroadcast{
 				UserId: context.CommandArgs.UserId,
@@ -0,0 +1,101 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/standup-raven/standup-raven/server/otime"
+	"github.com/standup-raven/standup-raven/server/standup"
+	"github.com/standup-raven/standup-raven/server/util"
+)
+
+func commandNext() *Config {
+	return &Config{
+		AutocompleteData: &model.AutocompleteData{
+			Trigger:  "next",
+			Hint:     "[count]",
+			HelpText: "List upcoming channel standup dates with their window times.",
+			RoleID:   model.SYSTEM_USER_ROLE_ID,
+			Arguments: []*model.AutocompleteArg{
+				{
+					HelpText: fmt.Sprintf("Number of standup dates to list, at most %d", standup.UpcomingStandupsMaxCount),
+					Type:     model.AutocompleteArgTypeText,
+					Required: false,
+					Data: &model.AutocompleteTextArg{
+						Hint:    "Count",
+						Pattern: "\\d+",
+					},
+				},
+			},
+		},
+		ExtraHelpText: fmt.Sprintf("* lists %d dates by default\n", standup.UpcomingStandupsDefaultCount) +
+			"* window times are in standup timezone\n" +
+			"* skipped dates are listed struck through",
+		Validate: validateCommandNext,
+		Execute:  executeCommandNext,
+	}
+}
+
+func validateCommandNext(args []string, context Context) (*model.CommandResponse, *model.AppError) {
+	if len(args) > 1 {
+		return util.SendEphemeralText("Please specify at most one argument, the number of standup dates to list.")
+	}
+
+	count := standup.UpcomingStandupsDefaultCount
+	if len(args) == 1 {
+		var err error
+		count, err = strconv.Atoi(args[0])
+		if err != nil || count < 1 || count > standup.UpcomingStandupsMaxCount {
+			return util.SendEphemeralText(fmt.Sprintf("Please specify a number between 1 and %d.", standup.UpcomingStandupsMaxCount))
+		}
+	}
+
+	standupConfig, err := standup.GetStandupConfig(context.CommandArgs.ChannelId, getStandupID(context))
+	if err != nil {
+		return util.SendEphemeralText("Error getting standup config of the channel")
+	}
+
+	if standupConfig == nil {
+		return util.SendEphemeralText("Standup not configured for the channel")
+	}
+
+	context.Props["standupConfig"] = standupConfig
+	context.Props["count"] = count
+	return nil, nil
+}
+
+func executeCommandNext(args []string, context Context) (*model.CommandResponse, *model.AppError) {
+	standupConfig := context.Props["standupConfig"].(*standup.Config)
+	count := context.Props["count"].(int)
+
+	upcomingStandups := standupConfig.UpcomingStandups(otime.Now(standupConfig.Timezone).Time, count)
+	if len(upcomingStandups) == 0 {
+		return util.SendEphemeralText("No upcoming standups are scheduled.")
+	}
+
+	text := fmt.Sprintf("Upcoming standups, in %s timezone:\n", standupConfig.Timezone)
+	if !standupConfig.Enabled {
+		text = "Standup is currently disabled. " + text
+	}
+
+	lines := make([]string, len(upcomingStandups))
+	for i, upcomingStandup := range upcomingStandups {
+		line := fmt.Sprintf(
+			"%s %s - %s",
+			upcomingStandup.WindowOpen.Format("Mon "+dateLayout),
+			upcomingStandup.WindowOpen.Format("15:04"),
+			upcomingStandup.WindowClose.Format("15:04"),
+		)
+
+		if upcomingStandup.Skipped {
+			line = "~~" + line + "~~ (skipped)"
+		}
+
+		lines[i] = "* " + line
+	}
+
+	return util.SendEphemeralText(text + strings.Join(lines, "\n"))
+}
@@ -0,0 +1,153 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/thoas/go-funk"
+
+	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/otime"
+	"github.com/standup-raven/standup-raven/server/standup"
+	"github.com/standup-raven/standup-raven/server/util"
+)
+
+const oooSubCommandClear = "clear"
+
+func commandOutOfOffice() *Config {
+	return &Config{
+		AutocompleteData: &model.AutocompleteData{
+			Trigger:  "ooo",
+			Hint:     "[from date] [to date] [username] | clear [username]",
+			HelpText: "Mark yourself, or a standup member, out of office between the specified dates.",
+			RoleID:   model.SYSTEM_USER_ROLE_ID,
+			Arguments: []*model.AutocompleteArg{
+				{
+					HelpText: "First date out of office in `DD-MM-YYYY` format, or `clear` to remove the out of office period",
+					Type:     model.AutocompleteArgTypeText,
+					Required: true,
+					Data: &model.AutocompleteTextArg{
+						Hint:    "From date",
+						Pattern: "\\d\\d-\\d\\d-\\d\\d\\d\\d|clear",
+					},
+				},
+			},
+		},
+		ExtraHelpText: "* dates must be in `DD-MM-YYYY` format\n" +
+			"* out of office members aren't reminded to fill their standup and are listed separately in standup reports\n" +
+			"* only channel admins can mark other members of the channel's standup out of office, specified by username",
+		Validate: validateCommandOutOfOffice,
+		Execute:  executeCommandOutOfOffice,
+	}
+}
+
+func validateCommandOutOfOffice(args []string, context Context) (*model.CommandResponse, *model.AppError) {
+	clearPeriod := len(args) > 0 && args[0] == oooSubCommandClear
+
+	var usernames []string
+	switch {
+	case clearPeriod && len(args) <= 2:
+		usernames = args[1:]
+	case !clearPeriod && (len(args) == 2 || len(args) == 3):
+		usernames = args[2:]
+	default:
+		return util.SendEphemeralText("Please specify the dates you will be out of office from and to, or `clear`.")
+	}
+
+	userID := context.CommandArgs.UserId
+	if len(usernames) > 0 {
+		// out of office periods apply to all standups of the user,
+		// so only admins can set them for others
+		if response, appErr := validateAdminPermission(context); response != nil || appErr != nil {
+			return response, appErr
+		}
+
+		standupConfig, err := standup.GetStandupConfig(context.CommandArgs.ChannelId, getStandupID(context))
+		if err != nil {
+			return util.SendEphemeralText("Error getting standup config of the channel")
+		}
+
+		if standupConfig == nil {
+			return util.SendEphemeralText("Standup not configured for the channel")
+		}
+
+		username := strings.TrimLeft(usernames[0], "@")
+		user, appErr := config.Mattermost.GetUserByUsername(username)
+		if appErr != nil {
+			return util.SendEphemeralText("Couldn't find user with username: " + username)
+		}
+
+		if !funk.ContainsString(standupConfig.Members, user.Id) {
+			return util.SendEphemeralText("@" + username + " is not a member of this standup.")
+		}
+
+		userID = user.Id
+	}
+
+	context.Props["userID"] = userID
+	context.Props["clear"] = clearPeriod
+	if clearPeriod {
+		return nil, nil
+	}
+
+	from, err := time.Parse(dateLayout, args[0])
+	if err != nil {
+		return util.SendEphemeralText(fmt.Sprintf("Error parsing this date: %s. Please specify date in format: DD-MM-YYYY", args[0]))
+	}
+
+	to, err := time.Parse(dateLayout, args[1])
+	if err != nil {
+		return util.SendEphemeralText(fmt.Sprintf("Error parsing this date: %s. Please specify date in format: DD-MM-YYYY", args[1]))
+	}
+
+	if to.Before(from) {
+		return util.SendEphemeralText("Out of office period cannot end before it starts.")
+	}
+
+	// a day before today in UTC is still today in some timezones
+	if to.Format(otime.LayoutISODate) < otime.Now("UTC").AddDate(0, 0, -1).Format(otime.LayoutISODate) {
+		return util.SendEphemeralText("Out of office period has already ended.")
+	}
+
+	context.Props["from"] = from
+	context.Props["to"] = to
+	return nil, nil
+}
+
+func executeCommandOutOfOffice(args []string, context Context) (*model.CommandResponse, *model.AppError) {
+	userID := context.Props["userID"].(string)
+
+	subject := "You are"
+	if userID != context.CommandArgs.UserId {
+		user, appErr := config.Mattermost.GetUser(userID)
+		if appErr != nil {
+			return util.SendEphemeralText("Error occurred while fetching user.")
+		}
+
+		subject = "@" + user.Username + " is"
+	}
+
+	if context.Props["clear"].(bool) {
+		cleared, err := standup.ClearUserOutOfOffice(userID)
+		if err != nil {
+			return util.SendEphemeralText("Error occurred while clearing out of office period.")
+		}
+
+		if !cleared {
+			return util.SendEphemeralText(subject + " not out of office.")
+		}
+
+		return util.SendEphemeralText(subject + " no longer out of office.")
+	}
+
+	from := context.Props["from"].(time.Time)
+	to := context.Props["to"].(time.Time)
+
+	if err := standup.SetUserOutOfOffice(userID, from, to); err != nil {
+		return util.SendEphemeralText("Error occurred while saving out of office period.")
+	}
+
+	return util.SendEphemeralText(fmt.Sprintf("%s out of office from %s to %s.", subject, from.Format(dateLayout), to.Format(dateLayout)))
+}
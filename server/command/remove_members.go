@@ -81,7 +81,7 @@ func validateRemoveMembers(args []string, context Context) (*model.CommandRespon
 
 func executeRemoveMembers(args []string, context Context) (*model.CommandResponse, *model.AppError) {
 	userIDs := context.Props["userIDs"].([]string)
-	userIDsNotInStandup, removedUserIDs, err := removeMembersFromStandup(userIDs, context.CommandArgs.ChannelId)
+	userIDsNotInStandup, removedUserIDs, err := removeMembersFromStandup(userIDs, context.CommandArgs.ChannelId, getStandupID(context), context.CommandArgs.UserId)
 	if err != nil {
 		return util.SendEphemeralText("An error occurred while removing members from standup")
 	}
@@ -118,8 +118,8 @@ func executeRemoveMembers(args []string, context Context) (*model.CommandRespons
 	}, nil
 }
 
-func removeMembersFromStandup(userIDs []string, channelID string) ([]string, []string, error) {
-	standupConfig, err := standup.GetStandupConfig(channelID)
+func removeMembersFromStandup(userIDs []string, channelID, standupID, userID string) ([]string, []string, error) {
+	standupConfig, err := standup.GetStandupConfig(channelID, standupID)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -135,7 +135,7 @@ func removeMembersFromStandup(userIDs []string, channelID string) ([]string, []s
 
 	membersRemovedFromStandup := util.Difference(originalMembers, standupConfig.Members)
 
-	_, err = standup.SaveStandupConfig(standupConfig)
+	_, err = standup.SaveStandupConfig(standupConfig, userID)
 	if err != nil {
 		return nil, nil, err
 	}
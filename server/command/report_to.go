@@ -0,0 +1,164 @@
+package command
+
+import (
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/standup"
+	"github.com/standup-raven/standup-raven/server/util"
+)
+
+const (
+	reportToSubCommandAdd    = "add"
+	reportToSubCommandRemove = "remove"
+)
+
+func commandReportTo() *Config {
+	return &Config{
+		AutocompleteData: &model.AutocompleteData{
+			Trigger:  "reportto",
+			Hint:     "[add | remove] [~channel] [@username]...",
+			HelpText: "List, add or remove other channels and users a copy of standup report is sent to.",
+			RoleID:   model.SYSTEM_USER_ROLE_ID,
+			Arguments: []*model.AutocompleteArg{
+				{
+					HelpText: "`add` or `remove` the specified report destinations. Lists report destinations if not specified",
+					Type:     model.AutocompleteArgTypeText,
+					Required: false,
+					Data: &model.AutocompleteTextArg{
+						Hint:    "add | remove",
+						Pattern: "add|remove",
+					},
+				},
+			},
+		},
+		ExtraHelpText: "* channels are specified as `~channel` and users as `@username`\n" +
+			"* a copy of the report is posted in the channels and sent to the users as a direct message\n" +
+			"* you can only add channels you are a member of and users who are members of this channel",
+		Validate: validateCommandReportTo,
+		Execute:  executeCommandReportTo,
+	}
+}
+
+func validateCommandReportTo(args []string, context Context) (*model.CommandResponse, *model.AppError) {
+	standupConfig, err := standup.GetStandupConfig(context.CommandArgs.ChannelId, getStandupID(context))
+	if err != nil {
+		return util.SendEphemeralText("Error getting standup config of the channel")
+	}
+
+	if standupConfig == nil {
+		return util.SendEphemeralText("Standup not configured for the channel")
+	}
+
+	context.Props["standupConfig"] = standupConfig
+	if len(args) == 0 {
+		return nil, nil
+	}
+
+	if args[0] != reportToSubCommandAdd && args[0] != reportToSubCommandRemove {
+		return util.SendEphemeralText("Please specify `add` or `remove`.")
+	}
+
+	if len(args) < 2 {
+		return util.SendEphemeralText("Please specify at least one channel or user.")
+	}
+
+	if response, appErr := validateConfigPermission(context); response != nil || appErr != nil {
+		return response, appErr
+	}
+
+	var channelIDs, userIDs []string
+	for _, arg := range args[1:] {
+		switch {
+		case strings.HasPrefix(arg, "~"):
+			name := strings.TrimPrefix(arg, "~")
+			channel, appErr := config.Mattermost.GetChannelByName(context.CommandArgs.TeamId, name, false)
+			if appErr != nil {
+				return util.SendEphemeralText("Couldn't find channel: " + arg)
+			}
+
+			// adding channels the user can't see would let them post reports in any channel
+			if args[0] == reportToSubCommandAdd {
+				if _, appErr := config.Mattermost.GetChannelMember(channel.Id, context.CommandArgs.UserId); appErr != nil {
+					return util.SendEphemeralText("You can only add channels you are a member of. You aren't a member of " + arg)
+				}
+			}
+
+			channelIDs = append(channelIDs, channel.Id)
+		case strings.HasPrefix(arg, "@"):
+			username := strings.TrimPrefix(arg, "@")
+			user, appErr := config.Mattermost.GetUserByUsername(username)
+			if appErr != nil {
+				return util.SendEphemeralText("Couldn't find user with username: " + username)
+			}
+
+			// the report can't be sent to users who can't see the standup channel
+			if args[0] == reportToSubCommandAdd {
+				if _, appErr := config.Mattermost.GetChannelMember(context.CommandArgs.ChannelId, user.Id); appErr != nil {
+					return util.SendEphemeralText("You can only add users who are members of this channel. " + arg + " isn't a member of it.")
+				}
+			}
+
+			userIDs = append(userIDs, user.Id)
+		default:
+			return util.SendEphemeralText("Please specify channels as `~channel` and users as `@username`. Couldn't understand: " + arg)
+		}
+	}
+
+	context.Props["channelIDs"] = channelIDs
+	context.Props["userIDs"] = userIDs
+	return nil, nil
+}
+
+func executeCommandReportTo(args []string, context Context) (*model.CommandResponse, *model.AppError) {
+	standupConfig := context.Props["standupConfig"].(*standup.Config)
+	if len(args) == 0 {
+		return util.SendEphemeralText(reportDestinationsText(standupConfig))
+	}
+
+	channelIDs := context.Props["channelIDs"].([]string)
+	userIDs := context.Props["userIDs"].([]string)
+
+	if args[0] == reportToSubCommandAdd {
+		if err := standupConfig.AddReportDestinations(channelIDs, userIDs); err != nil {
+			return util.SendEphemeralText("Couldn't add report destinations: " + err.Error())
+		}
+	} else if !standupConfig.RemoveReportDestinations(channelIDs, userIDs) {
+		return util.SendEphemeralText("Some of the specified channels or users aren't report destinations.")
+	}
+
+	if _, err := standup.SaveStandupConfig(standupConfig, context.CommandArgs.UserId); err != nil {
+		return util.SendEphemeralText("Error occurred while saving report destinations.")
+	}
+
+	return util.SendEphemeralText(reportDestinationsText(standupConfig))
+}
+
+// reportDestinationsText lists additional report destinations of standup.
+// Destinations are listed by their ID if their name can't be fetched.
+func reportDestinationsText(standupConfig *standup.Config) string {
+	if len(standupConfig.ReportChannels) == 0 && len(standupConfig.ReportUsers) == 0 {
+		return "Standup report is only posted in this channel."
+	}
+
+	var destinations []string
+	for _, channelID := range standupConfig.ReportChannels {
+		if channel, appErr := config.Mattermost.GetChannel(channelID); appErr == nil {
+			destinations = append(destinations, "~"+channel.Name)
+		} else {
+			destinations = append(destinations, "channel "+channelID)
+		}
+	}
+
+	for _, userID := range standupConfig.ReportUsers {
+		if user, appErr := config.Mattermost.GetUser(userID); appErr == nil {
+			destinations = append(destinations, "@"+user.Username)
+		} else {
+			destinations = append(destinations, "user "+userID)
+		}
+	}
+
+	return "A copy of standup report is sent to " + strings.Join(destinations, ", ") + "."
+}
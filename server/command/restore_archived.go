@@ -0,0 +1,35 @@
+package command
+
+import (
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/standup-raven/standup-raven/server/standup"
+	"github.com/standup-raven/standup-raven/server/util"
+)
+
+func commandRestoreArchived() *Config {
+	return &Config{
+		AutocompleteData: &model.AutocompleteData{
+			Trigger:  "restore-archived",
+			HelpText: "Restores the archived standup configuration of the current channel.",
+			RoleID:   model.SYSTEM_USER_ROLE_ID,
+		},
+		ExtraHelpText: "* standup configuration is archived when it couldn't be upgraded to a newer plugin version",
+		Validate:      validateCommandRestoreArchived,
+		Execute:       executeCommandRestoreArchived,
+	}
+}
+
+func validateCommandRestoreArchived(args []string, context Context) (*model.CommandResponse, *model.AppError) {
+	return validateConfigPermission(context)
+}
+
+func executeCommandRestoreArchived(args []string, context Context) (*model.CommandResponse, *model.AppError) {
+	standupConfig, err := standup.RestoreArchivedStandupConfig(context.CommandArgs.ChannelId, context.CommandArgs.UserId)
+	if err != nil {
+		return util.SendEphemeralText("Couldn't restore archived standup configuration. " + err.Error())
+	}
+
+	standup.PublishActiveChannelEvent(standupConfig.ChannelID, context.CommandArgs.UserId)
+	return util.SendEphemeralText("Archived standup configuration restored successfully.")
+}
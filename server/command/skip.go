@@ -0,0 +1,132 @@
+package command
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/standup-raven/standup-raven/server/standup"
+	"github.com/standup-raven/standup-raven/server/util"
+)
+
+func commandSkip() *Config {
+	return &Config{
+		AutocompleteData: &model.AutocompleteData{
+			Trigger:  "skip",
+			Hint:     "[date]",
+			HelpText: "Skip channel standup on the specified date, such as a public holiday.",
+			RoleID:   model.SYSTEM_USER_ROLE_ID,
+			Arguments: []*model.AutocompleteArg{
+				{
+					HelpText: "Date in `DD-MM-YYYY` format",
+					Type:     model.AutocompleteArgTypeText,
+					Required: true,
+					Data: &model.AutocompleteTextArg{
+						Hint:    "Date",
+						Pattern: "\\d\\d-\\d\\d-\\d\\d\\d\\d",
+					},
+				},
+			},
+		},
+		ExtraHelpText: "* date must be in `DD-MM-YYYY` format\n" +
+			"* no reminders or report are sent on skipped dates",
+		Validate: validateCommandSkip,
+		Execute:  executeCommandSkip,
+	}
+}
+
+func commandUnskip() *Config {
+	return &Config{
+		AutocompleteData: &model.AutocompleteData{
+			Trigger:  "unskip",
+			Hint:     "[date]",
+			HelpText: "Hold channel standup on the specified date, undoing a skip or adding a date outside the schedule.",
+			RoleID:   model.SYSTEM_USER_ROLE_ID,
+			Arguments: []*model.AutocompleteArg{
+				{
+					HelpText: "Date in `DD-MM-YYYY` format",
+					Type:     model.AutocompleteArgTypeText,
+					Required: true,
+					Data: &model.AutocompleteTextArg{
+						Hint:    "Date",
+						Pattern: "\\d\\d-\\d\\d-\\d\\d\\d\\d",
+					},
+				},
+			},
+		},
+		ExtraHelpText: "* date must be in `DD-MM-YYYY` format\n" +
+			"* dates not in standup schedule are added as additional standup dates",
+		Validate: validateCommandSkip,
+		Execute:  executeCommandUnskip,
+	}
+}
+
+// validateCommandSkip validates arguments of both skip and unskip commands.
+func validateCommandSkip(args []string, context Context) (*model.CommandResponse, *model.AppError) {
+	if len(args) != 1 {
+		return util.SendEphemeralText("Please specify a date.")
+	}
+
+	if response, appErr := validateConfigPermission(context); response != nil || appErr != nil {
+		return response, appErr
+	}
+
+	standupConfig, err := standup.GetStandupConfig(context.CommandArgs.ChannelId, getStandupID(context))
+	if err != nil {
+		return util.SendEphemeralText("Error getting standup config of the channel")
+	}
+
+	if standupConfig == nil {
+		return util.SendEphemeralText("Standup not configured for the channel")
+	}
+
+	location, err := time.LoadLocation(standupConfig.Timezone)
+	if err != nil {
+		return util.SendEphemeralText("Error loading timezone of the channel standup")
+	}
+
+	date, err := time.ParseInLocation(dateLayout, args[0], location)
+	if err != nil {
+		return util.SendEphemeralText(fmt.Sprintf("Error parsing this date: %s. Please specify date in format: DD-MM-YYYY", args[0]))
+	}
+
+	// the current standup may still be open after midnight for windows crossing midnight
+	if date.Before(standupConfig.CurrentStandupDate().Time) {
+		return util.SendEphemeralText("Cannot change standup schedule for past dates.")
+	}
+
+	context.Props["standupConfig"] = standupConfig
+	context.Props["date"] = date
+	return nil, nil
+}
+
+func executeCommandSkip(args []string, context Context) (*model.CommandResponse, *model.AppError) {
+	standupConfig := context.Props["standupConfig"].(*standup.Config)
+	date := context.Props["date"].(time.Time)
+
+	if err := standupConfig.SkipDate(date); err != nil {
+		return util.SendEphemeralText("Couldn't skip standup: " + err.Error())
+	}
+
+	if _, err := standup.SaveStandupConfig(standupConfig, context.CommandArgs.UserId); err != nil {
+		return util.SendEphemeralText("Error occurred while saving standup schedule.")
+	}
+
+	return util.SendEphemeralText("Standup skipped on " + date.Format(dateLayout) + ".")
+}
+
+func executeCommandUnskip(args []string, context Context) (*model.CommandResponse, *model.AppError) {
+	standupConfig := context.Props["standupConfig"].(*standup.Config)
+	date := context.Props["date"].(time.Time)
+
+	if err := standupConfig.UnskipDate(date); err != nil {
+		return util.SendEphemeralText("Couldn't schedule standup: " + err.Error())
+	}
+
+	if _, err := standup.SaveStandupConfig(standupConfig, context.CommandArgs.UserId); err != nil {
+		return util.SendEphemeralText("Error occurred while saving standup schedule.")
+	}
+
+	return util.SendEphemeralText("Standup scheduled on " + date.Format(dateLayout) + ".")
+}
@@ -62,7 +62,7 @@ func commandStandup() *Config {
 }
 
 func validateCommandStandup(args []string, context Context) (*model.CommandResponse, *model.AppError) {
-	standupConfig, err := standup.GetStandupConfig(context.CommandArgs.ChannelId)
+	standupConfig, err := standup.GetStandupConfig(context.CommandArgs.ChannelId, getStandupID(context))
 	if err != nil {
 		return util.SendEphemeralText("Error getting standup config of the channel")
 	}
@@ -100,7 +100,7 @@ func executeCommandStandup(args []string, context Context) (*model.CommandRespon
 	userID := context.CommandArgs.UserId
 
 	for _, date := range context.Props["dates"].([]otime.OTime) {
-		_ = notification.SendStandupReport([]string{channelID}, date, visibility, userID, false)
+		_ = notification.SendStandupReport([]string{channelID}, getStandupID(context), date, visibility, userID, false)
 	}
 
 	return &model.CommandResponse{
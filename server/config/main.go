@@ -8,8 +8,6 @@ import (
 
 	"github.com/mattermost/mattermost-server/v5/plugin"
 	"go.uber.org/atomic"
-
-	"github.com/standup-raven/standup-raven/server/otime"
 )
 
 const (
@@ -22,13 +20,24 @@ const (
 
 	HeaderMattermostUserID = "Mattermost-User-Id"
 
+	// paths of post actions on reminders, relative to URLPluginBase
+	PathActionFillStandup = "/action/fill-standup"
+	PathActionSkipStandup = "/action/skip-standup"
+
 	ReportFormatUserAggregated = "user_aggregated"
 	ReportFormatTypeAggregated = "type_aggregated"
+	ReportFormatCustom         = "custom"
 
 	CacheKeyPrefixNotificationStatus = "notif_status"
 	CacheKeyPrefixTeamStandupConfig  = "standup_config_"
+	CacheKeyPrefixReminderPosts      = "reminderPosts"
+	CacheKeyPrefixConfigHistory      = "standup_config_history_"
+	CacheKeyPrefixLastProcessed      = "last_processed_occurrence_"
 
-	CacheKeyAllStandupChannels = "all_standup_channels"
+	CacheKeyAllStandupChannels    = "all_standup_channels"
+	CacheKeyDatabaseSchemaVersion = "database_schema_version"
+	CacheKeyDataPurgeStatus       = "data_purge_status"
+	CacheKeyOutOfOffice           = "out_of_office"
 
 	WindowCloseNotificationDurationPercentage = 0.8 // 80%
 
@@ -42,6 +51,10 @@ const (
 	// the date changed between 23:59 and 00:00:xx2.
 	RunnerInterval = 25 * time.Second
 
+	// Purging only needs to run once a day per channel,
+	// running it hourly makes sure it's never delayed by much.
+	DataPurgeInterval = time.Hour
+
 	BotUsername     = "raven"
 	BotDisplayName  = "Raven"
 	OverrideIconURL = URLStaticBase + "/logo.png"
@@ -50,7 +63,7 @@ const (
 var (
 	config        atomic.Value
 	Mattermost    plugin.API
-	ReportFormats = []string{ReportFormatUserAggregated, ReportFormatTypeAggregated}
+	ReportFormats = []string{ReportFormatUserAggregated, ReportFormatTypeAggregated, ReportFormatCustom}
 )
 
 type Configuration struct {
@@ -64,6 +77,7 @@ type Configuration struct {
 	PluginVersion           string `json:"plugin_version"`
 	PermissionSchemaEnabled bool   `json:"permissionSchemaEnabled"`
 	EnableErrorReporting    bool   `json:"enableErrorReporting"`
+	DataRetentionDays       int    `json:"dataRetentionDays"`
 }
 
 func GetConfig() *Configuration {
@@ -95,8 +109,12 @@ func (c *Configuration) ProcessConfiguration() error {
 		return errors.New("sentry webapp DSN cannot be empty if error reporting is enabled")
 	}
 
+	if c.DataRetentionDays < 0 {
+		Mattermost.LogError("Data retention period cannot be negative")
+		return errors.New("data retention period cannot be negative")
+	}
+
 	c.Location = location
-	otime.DefaultLocation = location
 	return nil
 }
 
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/controller/middleware"
+	"github.com/standup-raven/standup-raven/server/logger"
+	"github.com/standup-raven/standup-raven/server/standup"
+	"github.com/standup-raven/standup-raven/server/standup/notification"
+)
+
+var fillStandupAction = &Endpoint{
+	Path:    config.PathActionFillStandup,
+	Method:  http.MethodPost,
+	Execute: authenticatedControllerWrapper(executeFillStandupAction),
+	Middlewares: []middleware.Middleware{
+		middleware.Authenticated,
+	},
+}
+
+var skipStandupAction = &Endpoint{
+	Path:    config.PathActionSkipStandup,
+	Method:  http.MethodPost,
+	Execute: authenticatedControllerWrapper(executeSkipStandupAction),
+	Middlewares: []middleware.Middleware{
+		middleware.Authenticated,
+	},
+}
+
+// executeFillStandupAction opens standup modal for the user clicking the post action.
+// Standup is identified by the action context.
+func executeFillStandupAction(userID string, w http.ResponseWriter, r *http.Request) error {
+	request := model.PostActionIntegrationRequestFromJson(r.Body)
+	if request == nil {
+		http.Error(w, "Invalid post action request", http.StatusBadRequest)
+		return errors.New("invalid post action request")
+	}
+
+	channelID, _ := request.Context["channel_id"].(string)
+	standupID, _ := request.Context["standup_id"].(string)
+
+	config.Mattermost.PublishWebSocketEvent(
+		"open_standup_modal",
+		map[string]interface{}{
+			"channel_id": channelID,
+			"standup_id": standupID,
+		},
+		&model.WebsocketBroadcast{
+			UserId: userID,
+		},
+	)
+
+	return writePostActionResponse(w, &model.PostActionIntegrationResponse{})
+}
+
+// executeSkipStandupAction skips today's standup of the user clicking the post action.
+// The outcome is shown to the user as an ephemeral message.
+func executeSkipStandupAction(userID string, w http.ResponseWriter, r *http.Request) error {
+	request := model.PostActionIntegrationRequestFromJson(r.Body)
+	if request == nil {
+		http.Error(w, "Invalid post action request", http.StatusBadRequest)
+		return errors.New("invalid post action request")
+	}
+
+	channelID, _ := request.Context["channel_id"].(string)
+	standupID, _ := request.Context["standup_id"].(string)
+
+	standupConfig, err := standup.GetStandupConfig(channelID, standupID)
+	if err != nil {
+		http.Error(w, "Couldn't fetch channel standup configuration", http.StatusInternalServerError)
+		return err
+	}
+
+	if standupConfig == nil {
+		http.Error(w, "Standup not configured for this channel", http.StatusNotFound)
+		return nil
+	}
+
+	date, err := notification.SkipMemberStandup(standupConfig, userID)
+	if err != nil {
+		return writePostActionResponse(w, &model.PostActionIntegrationResponse{
+			EphemeralText: "Couldn't skip your standup: " + err.Error() + ".",
+		})
+	}
+
+	return writePostActionResponse(w, &model.PostActionIntegrationResponse{
+		EphemeralText: "You have skipped your standup for " + date.Format("2 Jan 2006") + ".",
+	})
+}
+
+func writePostActionResponse(w http.ResponseWriter, response *model.PostActionIntegrationResponse) error {
+	data := response.ToJson()
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		logger.Error("Error occurred in writing data to HTTP response", err, map[string]interface{}{"data": string(data)})
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/standup-raven/standup-raven/server/controller/middleware"
+	"github.com/standup-raven/standup-raven/server/logger"
+	"github.com/standup-raven/standup-raven/server/standup"
+)
+
+var getArchivedConfigs = &Endpoint{
+	Path:    "/archived-configs",
+	Method:  http.MethodGet,
+	Execute: executeGetArchivedConfigs,
+	Middlewares: []middleware.Middleware{
+		middleware.Authenticated,
+		middleware.RequireSystemAdmin,
+	},
+}
+
+var restoreArchivedConfig = &Endpoint{
+	Path:    "/archived-configs/restore",
+	Method:  http.MethodPost,
+	Execute: authenticatedControllerWrapper(executeRestoreArchivedConfig),
+	Middlewares: []middleware.Middleware{
+		middleware.Authenticated,
+		middleware.RequireSystemAdmin,
+	},
+}
+
+func executeGetArchivedConfigs(w http.ResponseWriter, r *http.Request) error {
+	archivedConfigs, err := standup.GetArchivedStandupConfigs()
+	if err != nil {
+		http.Error(w, "Couldn't fetch archived standup configurations", http.StatusInternalServerError)
+		return err
+	}
+
+	data, err := json.Marshal(archivedConfigs)
+	if err != nil {
+		http.Error(w, "Couldn't parse archived standup configurations", http.StatusInternalServerError)
+		logger.Error("Couldn't serialize archived standup configs", err, nil)
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		logger.Error("Error occurred in writing data to HTTP response", err, map[string]interface{}{"data": string(data)})
+		return err
+	}
+
+	return nil
+}
+
+func executeRestoreArchivedConfig(userID string, w http.ResponseWriter, r *http.Request) error {
+	channelID := r.URL.Query().Get("channel_id")
+
+	conf, err := standup.RestoreArchivedStandupConfig(channelID, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return err
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if _, err := w.Write([]byte(conf.ToJSON())); err != nil {
+		logger.Error("Error occurred in writing data to HTTP response", err, map[string]interface{}{"config": conf.ToJSON()})
+		return err
+	}
+
+	standup.PublishActiveChannelEvent(conf.ChannelID, userID)
+	return nil
+}
@@ -5,12 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
-	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/thoas/go-funk"
 
 	"github.com/standup-raven/standup-raven/server/config"
 	"github.com/standup-raven/standup-raven/server/controller/middleware"
 	"github.com/standup-raven/standup-raven/server/logger"
+	"github.com/standup-raven/standup-raven/server/otime"
 	"github.com/standup-raven/standup-raven/server/standup"
 	"github.com/standup-raven/standup-raven/server/util"
 )
@@ -36,6 +38,48 @@ var setConfig = &Endpoint{
 	},
 }
 
+var getConfigHistory = &Endpoint{
+	Path:    "/config/history",
+	Method:  http.MethodGet,
+	Execute: executeGetConfigHistory,
+	Middlewares: []middleware.Middleware{
+		middleware.Authenticated,
+		middleware.RequireChannelMember,
+	},
+}
+
+var getSchedulePreview = &Endpoint{
+	Path:    "/schedule/preview",
+	Method:  http.MethodGet,
+	Execute: executeGetSchedulePreview,
+	Middlewares: []middleware.Middleware{
+		middleware.Authenticated,
+		middleware.RequireChannelMember,
+	},
+}
+
+var restoreConfig = &Endpoint{
+	Path:    "/config/restore",
+	Method:  http.MethodPost,
+	Execute: authenticatedControllerWrapper(executeRestoreConfig),
+	Middlewares: []middleware.Middleware{
+		middleware.Authenticated,
+		middleware.SetUserRoles,
+		middleware.DisallowGuests,
+		middleware.HandlePermissionSchema,
+	},
+}
+
+var getChannelStandups = &Endpoint{
+	Path:    "/standups",
+	Method:  http.MethodGet,
+	Execute: executeGetChannelStandups,
+	Middlewares: []middleware.Middleware{
+		middleware.Authenticated,
+		middleware.RequireChannelMember,
+	},
+}
+
 var getDefaultTimezone = &Endpoint{
 	Path:    "/timezone",
 	Method:  http.MethodGet,
@@ -53,8 +97,9 @@ var getActiveStandupChannels = &Endpoint{
 
 func executeGetConfig(userID string, w http.ResponseWriter, r *http.Request) error {
 	channelID := r.URL.Query().Get("channel_id")
+	standupID := r.URL.Query().Get("standup_id")
 
-	c, err := standup.GetStandupConfig(channelID)
+	c, err := standup.GetStandupConfig(channelID, standupID)
 	if err != nil {
 		http.Error(w, "Couldn't fetch channel standup configuration", http.StatusInternalServerError)
 		return err
@@ -100,6 +145,11 @@ func executeSetConfig(userID string, w http.ResponseWriter, r *http.Request) err
 		return errors.New("channel ID provided in config body does not match with the value in query params")
 	}
 
+	if conf.StandupID != r.URL.Query().Get("standup_id") {
+		http.Error(w, "Mismatched standup ID", http.StatusBadRequest)
+		return errors.New("standup ID provided in config body does not match with the value in query params")
+	}
+
 	if err := conf.PreSave(); err != nil {
 		http.Error(w, "Couldn't save standup configuration", http.StatusBadRequest)
 		return err
@@ -110,6 +160,36 @@ func executeSetConfig(userID string, w http.ResponseWriter, r *http.Request) err
 		return err
 	}
 
+	oldConf, err := standup.GetStandupConfig(conf.ChannelID, conf.StandupID)
+	if err != nil {
+		http.Error(w, "Couldn't fetch channel standup configuration", http.StatusInternalServerError)
+		return err
+	}
+
+	// reports can't be sent to channels the user can't see
+	for _, reportChannelID := range conf.ReportChannels {
+		if oldConf != nil && funk.ContainsString(oldConf.ReportChannels, reportChannelID) {
+			continue
+		}
+
+		if _, appErr := config.Mattermost.GetChannelMember(reportChannelID, userID); appErr != nil {
+			http.Error(w, "You can only add report channels you are a member of", http.StatusForbidden)
+			return errors.New(appErr.Error())
+		}
+	}
+
+	// reports can't be sent to users who can't see the standup channel
+	for _, reportUserID := range conf.ReportUsers {
+		if oldConf != nil && funk.ContainsString(oldConf.ReportUsers, reportUserID) {
+			continue
+		}
+
+		if _, appErr := config.Mattermost.GetChannelMember(conf.ChannelID, reportUserID); appErr != nil {
+			http.Error(w, "You can only add report users who are members of the standup channel", http.StatusForbidden)
+			return errors.New(appErr.Error())
+		}
+	}
+
 	json, err := json.Marshal(conf)
 	if err != nil {
 		fmt.Println(err)
@@ -117,7 +197,7 @@ func executeSetConfig(userID string, w http.ResponseWriter, r *http.Request) err
 		fmt.Println(string(json))
 	}
 
-	conf, err = standup.SaveStandupConfig(conf)
+	conf, err = standup.SaveStandupConfig(conf, userID)
 	if err != nil {
 		http.Error(w, "Error occurred while saving standup conf", http.StatusInternalServerError)
 		return err
@@ -127,7 +207,7 @@ func executeSetConfig(userID string, w http.ResponseWriter, r *http.Request) err
 	// 	1. Standup Raven channel header button won't show up ever in
 	//			this channel, even if standup is configured in the channel.
 	// 	2. Scheduled standup reports won't work for this channel.
-	if err := standup.AddStandupChannel(conf.ChannelID); err != nil {
+	if err := standup.AddStandupChannel(conf.ChannelID, conf.StandupID); err != nil {
 		http.Error(w, "Error occurred while saving standup conf", http.StatusInternalServerError)
 		return err
 	}
@@ -138,22 +218,125 @@ func executeSetConfig(userID string, w http.ResponseWriter, r *http.Request) err
 		return err
 	}
 
-	event := ""
-	if conf.Enabled {
-		event = "add_active_channel"
-	} else {
-		event = "remove_active_channel"
+	standup.PublishActiveChannelEvent(conf.ChannelID, userID)
+	return nil
+}
+
+func executeGetConfigHistory(w http.ResponseWriter, r *http.Request) error {
+	channelID := r.URL.Query().Get("channel_id")
+	standupID := r.URL.Query().Get("standup_id")
+
+	history, err := standup.GetStandupConfigHistory(channelID, standupID)
+	if err != nil {
+		http.Error(w, "Couldn't fetch channel standup configuration history", http.StatusInternalServerError)
+		return err
+	}
+
+	data, err := json.Marshal(history)
+	if err != nil {
+		http.Error(w, "Couldn't parse channel standup configuration history", http.StatusInternalServerError)
+		logger.Error("Couldn't serialize config history data", err, nil)
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		logger.Error("Error occurred in writing data to HTTP response", err, map[string]interface{}{"data": string(data)})
+		return err
+	}
+
+	return nil
+}
+
+func executeGetSchedulePreview(w http.ResponseWriter, r *http.Request) error {
+	channelID := r.URL.Query().Get("channel_id")
+	standupID := r.URL.Query().Get("standup_id")
+
+	count := standup.UpcomingStandupsDefaultCount
+	if countParam := r.URL.Query().Get("count"); countParam != "" {
+		var err error
+		count, err = strconv.Atoi(countParam)
+		if err != nil || count < 1 || count > standup.UpcomingStandupsMaxCount {
+			http.Error(w, fmt.Sprintf("Invalid count specified. Count must be between 1 and %d", standup.UpcomingStandupsMaxCount), http.StatusBadRequest)
+			return errors.New("invalid count specified: " + countParam)
+		}
+	}
+
+	standupConfig, err := standup.GetStandupConfig(channelID, standupID)
+	if err != nil {
+		http.Error(w, "Couldn't fetch channel standup configuration", http.StatusInternalServerError)
+		return err
+	}
+
+	if standupConfig == nil {
+		http.Error(w, "Standup not configured for this channel", http.StatusNotFound)
+		return nil
+	}
+
+	upcomingStandups := standupConfig.UpcomingStandups(otime.Now(standupConfig.Timezone).Time, count)
+
+	data, err := json.Marshal(upcomingStandups)
+	if err != nil {
+		http.Error(w, "Couldn't parse channel standup schedule", http.StatusInternalServerError)
+		logger.Error("Couldn't serialize standup schedule data", err, nil)
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		logger.Error("Error occurred in writing data to HTTP response", err, map[string]interface{}{"data": string(data)})
+		return err
+	}
+
+	return nil
+}
+
+func executeRestoreConfig(userID string, w http.ResponseWriter, r *http.Request) error {
+	channelID := r.URL.Query().Get("channel_id")
+	standupID := r.URL.Query().Get("standup_id")
+
+	version, err := strconv.Atoi(r.URL.Query().Get("version"))
+	if err != nil {
+		http.Error(w, "Invalid version specified", http.StatusBadRequest)
+		return err
+	}
+
+	conf, err := standup.RestoreStandupConfigVersion(channelID, standupID, version, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return err
+	}
+
+	if _, err := w.Write([]byte(conf.ToJSON())); err != nil {
+		logger.Error("Error occurred in writing data to HTTP response", err, map[string]interface{}{"config": conf.ToJSON()})
+		return err
+	}
+
+	standup.PublishActiveChannelEvent(conf.ChannelID, userID)
+	return nil
+}
+
+func executeGetChannelStandups(w http.ResponseWriter, r *http.Request) error {
+	channelID := r.URL.Query().Get("channel_id")
+
+	standupConfigs, err := standup.GetChannelStandupConfigs(channelID)
+	if err != nil {
+		http.Error(w, "Couldn't fetch channel standups", http.StatusInternalServerError)
+		return err
+	}
+
+	data, err := json.Marshal(standupConfigs)
+	if err != nil {
+		http.Error(w, "Couldn't parse channel standups", http.StatusInternalServerError)
+		logger.Error("Couldn't serialize channel standups", err, nil)
+		return err
 	}
 
-	config.Mattermost.PublishWebSocketEvent(
-		event,
-		map[string]interface{}{
-			"channel_id": conf.ChannelID,
-		},
-		&model.WebsocketBroadcast{
-			UserId: userID,
-		},
-	)
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		logger.Error("Error occurred in writing data to HTTP response", err, map[string]interface{}{"data": string(data)})
+		return err
+	}
 
 	return nil
 }
@@ -186,16 +369,22 @@ func executeGetActiveStandupChannels(w http.ResponseWriter, r *http.Request) err
 	}
 
 	activeStandupChannels := []string{}
+	isActive := map[string]bool{}
+
+	for standupKey, channelID := range standupChannels {
+		if isActive[channelID] {
+			continue
+		}
 
-	for _, channelID := range standupChannels {
-		standupConfig, err := standup.GetStandupConfig(channelID)
+		standupConfig, err := standup.GetStandupConfig(channelID, standup.ParseStandupKey(standupKey, channelID))
 		if err != nil {
 			logger.Error("An error occurred while fetching standup config for channel", err, map[string]interface{}{"channelID": channelID})
 			http.Error(w, "An error occurred while fetching standup config", http.StatusInternalServerError)
 			return err
 		}
 
-		if standupConfig.Enabled {
+		if standupConfig != nil && standupConfig.Enabled {
+			isActive[channelID] = true
 			activeStandupChannels = append(activeStandupChannels, channelID)
 		}
 	}
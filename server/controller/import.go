@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/standup-raven/standup-raven/server/controller/middleware"
+	"github.com/standup-raven/standup-raven/server/logger"
+	"github.com/standup-raven/standup-raven/server/standup"
+	"github.com/standup-raven/standup-raven/server/util"
+)
+
+var importData = &Endpoint{
+	Path:    "/import",
+	Method:  http.MethodPost,
+	Execute: authenticatedControllerWrapper(executeImportData),
+	Middlewares: []middleware.Middleware{
+		middleware.Authenticated,
+		middleware.RequireSystemAdmin,
+	},
+}
+
+func executeImportData(userID string, w http.ResponseWriter, r *http.Request) error {
+	data := &standup.ImportData{}
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(data); err != nil {
+		logger.Error("Could not decode request body", err, map[string]interface{}{"request": util.DumpRequest(r)})
+		http.Error(w, "Could not decode request body", http.StatusBadRequest)
+		return err
+	}
+
+	result := standup.Import(data, userID)
+
+	responseData, err := json.Marshal(result)
+	if err != nil {
+		http.Error(w, "Couldn't parse import result", http.StatusInternalServerError)
+		logger.Error("Couldn't serialize import result", err, nil)
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(responseData); err != nil {
+		logger.Error("Error occurred in writing data to HTTP response", err, map[string]interface{}{"data": string(responseData)})
+		return err
+	}
+
+	return nil
+}
@@ -22,11 +22,22 @@ var Endpoints = map[string]*Endpoint{
 	getEndpointKey(hook):                     hook,
 	getEndpointKey(getStandup):               getStandup,
 	getEndpointKey(saveStandup):              saveStandup,
+	getEndpointKey(getStandupHistory):        getStandupHistory,
+	getEndpointKey(exportStandups):           exportStandups,
 	getEndpointKey(getConfig):                getConfig,
 	getEndpointKey(setConfig):                setConfig,
+	getEndpointKey(getConfigHistory):         getConfigHistory,
+	getEndpointKey(getSchedulePreview):       getSchedulePreview,
+	getEndpointKey(getChannelStandups):       getChannelStandups,
+	getEndpointKey(restoreConfig):            restoreConfig,
 	getEndpointKey(getDefaultTimezone):       getDefaultTimezone,
 	getEndpointKey(getActiveStandupChannels): getActiveStandupChannels,
 	getEndpointKey(getPluginConfig):          getPluginConfig,
+	getEndpointKey(getArchivedConfigs):       getArchivedConfigs,
+	getEndpointKey(restoreArchivedConfig):    restoreArchivedConfig,
+	getEndpointKey(importData):               importData,
+	getEndpointKey(fillStandupAction):        fillStandupAction,
+	getEndpointKey(skipStandupAction):        skipStandupAction,
 }
 
 func getEndpointKey(endpoint *Endpoint) string {
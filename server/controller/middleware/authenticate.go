@@ -106,3 +106,40 @@ func HandlePermissionSchema(w http.ResponseWriter, r *http.Request) (*http.Reque
 
 	return r, nil
 }
+
+// RequireSystemAdmin middleware allows only system admins to access the endpoint.
+func RequireSystemAdmin(w http.ResponseWriter, r *http.Request) (*http.Request, *model.AppError) {
+	rawUserID := r.Context().Value(CtxKeyUserID)
+	if rawUserID == nil {
+		return nil, model.NewAppError("RequireSystemAdmin", "couldn't find user ID in context", nil, "Couldn't authenticate user.", http.StatusInternalServerError)
+	}
+
+	userID := rawUserID.(string)
+	user, appErr := config.Mattermost.GetUser(userID)
+	if appErr != nil {
+		return nil, model.NewAppError("RequireSystemAdmin", appErr.Error(), map[string]interface{}{"userID": userID}, "Couldn't verify user roles.", http.StatusInternalServerError)
+	}
+
+	if !user.IsSystemAdmin() {
+		return nil, model.NewAppError("RequireSystemAdmin", "", map[string]interface{}{"userID": userID}, "Only system admins are allowed to perform this operation.", http.StatusForbidden)
+	}
+
+	return r, nil
+}
+
+// RequireChannelMember middleware allows only members of the channel
+// specified in `channel_id` query param to access the endpoint.
+func RequireChannelMember(w http.ResponseWriter, r *http.Request) (*http.Request, *model.AppError) {
+	rawUserID := r.Context().Value(CtxKeyUserID)
+	if rawUserID == nil {
+		return nil, model.NewAppError("RequireChannelMember", "couldn't find user ID in context", nil, "Couldn't authenticate user.", http.StatusInternalServerError)
+	}
+
+	userID := rawUserID.(string)
+	channelID := r.URL.Query().Get("channel_id")
+	if _, appErr := config.Mattermost.GetChannelMember(channelID, userID); appErr != nil {
+		return nil, model.NewAppError("RequireChannelMember", appErr.Error(), map[string]interface{}{"userID": userID, "channelID": channelID}, "You do not have access to this channel", http.StatusForbidden)
+	}
+
+	return r, nil
+}
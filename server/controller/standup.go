@@ -3,12 +3,18 @@ package controller
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 
+	"github.com/thoas/go-funk"
+
+	"github.com/standup-raven/standup-raven/server/config"
 	"github.com/standup-raven/standup-raven/server/controller/middleware"
 	"github.com/standup-raven/standup-raven/server/logger"
 	"github.com/standup-raven/standup-raven/server/otime"
 	"github.com/standup-raven/standup-raven/server/standup"
+	"github.com/standup-raven/standup-raven/server/standup/notification"
 )
 
 var getStandup = &Endpoint{
@@ -31,6 +37,26 @@ var saveStandup = &Endpoint{
 	},
 }
 
+var getStandupHistory = &Endpoint{
+	Path:    "/standup/history",
+	Method:  http.MethodGet,
+	Execute: authenticatedControllerWrapper(executeGetStandupHistory),
+	Middlewares: []middleware.Middleware{
+		middleware.Authenticated,
+		middleware.RequireChannelMember,
+	},
+}
+
+var exportStandups = &Endpoint{
+	Path:    "/standup/export",
+	Method:  http.MethodGet,
+	Execute: authenticatedControllerWrapper(executeExportStandups),
+	Middlewares: []middleware.Middleware{
+		middleware.Authenticated,
+		middleware.RequireChannelMember,
+	},
+}
+
 func executeSaveStandup(userID string, w http.ResponseWriter, r *http.Request) error {
 	userStandup := &standup.UserStandup{}
 	decoder := json.NewDecoder(r.Body)
@@ -48,6 +74,11 @@ func executeSaveStandup(userID string, w http.ResponseWriter, r *http.Request) e
 		return errors.New("channel ID provided in standup body does not match with the value in query params")
 	}
 
+	if userStandup.StandupID != r.URL.Query().Get("standup_id") {
+		http.Error(w, "Mismatched standup ID", http.StatusBadRequest)
+		return errors.New("standup ID provided in standup body does not match with the value in query params")
+	}
+
 	userStandup.UserID = userID
 
 	if err := userStandup.IsValid(); err != nil {
@@ -61,6 +92,11 @@ func executeSaveStandup(userID string, w http.ResponseWriter, r *http.Request) e
 		return err
 	}
 
+	// the standup is saved even if it couldn't be posted as a late update
+	if err := notification.PostLateStandup(userStandup); err != nil {
+		logger.Error("Couldn't post late standup update", err, map[string]interface{}{"channelID": channelID, "userID": userID})
+	}
+
 	if _, err := w.Write([]byte("ok")); err != nil {
 		logger.Error("Error occurred in writing data to HTTP response", err, nil)
 		return err
@@ -71,7 +107,8 @@ func executeSaveStandup(userID string, w http.ResponseWriter, r *http.Request) e
 
 func executeGetStandup(userID string, w http.ResponseWriter, r *http.Request) error {
 	channelID := r.URL.Query().Get("channel_id")
-	standupConfig, err := standup.GetStandupConfig(channelID)
+	standupID := r.URL.Query().Get("standup_id")
+	standupConfig, err := standup.GetStandupConfig(channelID, standupID)
 	if err != nil {
 		http.Error(w, "Error occurred while fetching standup config", http.StatusInternalServerError)
 		return err
@@ -81,7 +118,13 @@ func executeGetStandup(userID string, w http.ResponseWriter, r *http.Request) er
 		return errors.New("standup not configured for channel: " + channelID)
 	}
 
-	userStandup, err := standup.GetUserStandup(userID, channelID, otime.Now(standupConfig.Timezone))
+	date, err := standup.GetMemberStandupDate(standupConfig, userID)
+	if err != nil {
+		http.Error(w, "Error occurred while fetching user standup", http.StatusInternalServerError)
+		return err
+	}
+
+	userStandup, err := standup.GetUserStandup(userID, channelID, standupID, date)
 	if err != nil {
 		http.Error(w, "Error occurred while fetching user standup", http.StatusInternalServerError)
 		return err
@@ -105,3 +148,150 @@ func executeGetStandup(userID string, w http.ResponseWriter, r *http.Request) er
 
 	return nil
 }
+
+func executeGetStandupHistory(userID string, w http.ResponseWriter, r *http.Request) error {
+	query := r.URL.Query()
+	channelID := query.Get("channel_id")
+	standupID := query.Get("standup_id")
+
+	// any channel member can view standup history of any standup member,
+	// defaulting to their own
+	standupUserID := query.Get("user_id")
+	if standupUserID == "" {
+		standupUserID = userID
+	}
+
+	standupConfig, err := standup.GetStandupConfig(channelID, standupID)
+	if err != nil {
+		http.Error(w, "Error occurred while fetching standup config", http.StatusInternalServerError)
+		return err
+	}
+	if standupConfig == nil {
+		http.Error(w, "Standup not configured for channel", http.StatusNotFound)
+		return errors.New("standup not configured for channel: " + channelID)
+	}
+
+	isMember, err := standup.IsStandupMember(standupConfig, standupUserID)
+	if err != nil {
+		http.Error(w, "Error occurred while fetching standup members", http.StatusInternalServerError)
+		return err
+	}
+	if !isMember {
+		http.Error(w, "User is not a member of the standup", http.StatusForbidden)
+		return errors.New("user " + standupUserID + " is not a member of standup of channel: " + channelID)
+	}
+
+	from, err := otime.ParseDate(query.Get("from"), standupConfig.Timezone)
+	if err != nil {
+		http.Error(w, "Invalid start date. Dates must be in YYYY-MM-DD format", http.StatusBadRequest)
+		return err
+	}
+
+	to, err := otime.ParseDate(query.Get("to"), standupConfig.Timezone)
+	if err != nil {
+		http.Error(w, "Invalid end date. Dates must be in YYYY-MM-DD format", http.StatusBadRequest)
+		return err
+	}
+
+	if err := standup.ValidateStandupHistoryRange(from, to); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return err
+	}
+
+	history, err := standup.GetUserStandupHistory(standupUserID, channelID, standupID, from, to)
+	if err != nil {
+		http.Error(w, "Error occurred while fetching user standup history", http.StatusInternalServerError)
+		return err
+	}
+
+	data, err := json.Marshal(history)
+	if err != nil {
+		logger.Error("Error occurred while marshaling user standup history", err, nil)
+		http.Error(w, "Error occurred while marshaling user standup history", http.StatusInternalServerError)
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		logger.Error("Error occurred in writing data to HTTP response", err, nil)
+		return err
+	}
+
+	return nil
+}
+
+func executeExportStandups(userID string, w http.ResponseWriter, r *http.Request) error {
+	query := r.URL.Query()
+	channelID := query.Get("channel_id")
+	standupID := query.Get("standup_id")
+
+	format := strings.ToLower(query.Get("format"))
+	if format == "" {
+		format = standup.ExportFormatCSV
+	}
+
+	if !funk.ContainsString(standup.ExportFormats, format) {
+		http.Error(w, "Invalid export format. Format can be one of: "+strings.Join(standup.ExportFormats, ", "), http.StatusBadRequest)
+		return errors.New("invalid export format: " + format)
+	}
+
+	channel, appErr := config.Mattermost.GetChannel(channelID)
+	if appErr != nil {
+		http.Error(w, "Error occurred while fetching channel", http.StatusInternalServerError)
+		return errors.New(appErr.Error())
+	}
+
+	standupConfig, err := standup.GetStandupConfig(channelID, standupID)
+	if err != nil {
+		http.Error(w, "Error occurred while fetching standup config", http.StatusInternalServerError)
+		return err
+	}
+	if standupConfig == nil {
+		http.Error(w, "Standup not configured for channel", http.StatusNotFound)
+		return errors.New("standup not configured for channel: " + channelID)
+	}
+
+	from, err := otime.ParseDate(query.Get("from"), standupConfig.Timezone)
+	if err != nil {
+		http.Error(w, "Invalid start date. Dates must be in YYYY-MM-DD format", http.StatusBadRequest)
+		return err
+	}
+
+	to, err := otime.ParseDate(query.Get("to"), standupConfig.Timezone)
+	if err != nil {
+		http.Error(w, "Invalid end date. Dates must be in YYYY-MM-DD format", http.StatusBadRequest)
+		return err
+	}
+
+	if err := standup.ValidateStandupHistoryRange(from, to); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return err
+	}
+
+	rows, err := standup.GetStandupExport(channelID, standupID, from, to)
+	if err != nil {
+		http.Error(w, "Error occurred while exporting standups", http.StatusInternalServerError)
+		return err
+	}
+
+	data, err := standup.EncodeStandupExport(rows, format)
+	if err != nil {
+		logger.Error("Error occurred while encoding standup export", err, nil)
+		http.Error(w, "Error occurred while encoding standup export", http.StatusInternalServerError)
+		return err
+	}
+
+	contentType := "text/csv"
+	if format == standup.ExportFormatJSON {
+		contentType = "application/json"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", standup.GetStandupExportFileName(channel.Name, standupID, from, to, format)))
+	if _, err := w.Write(data); err != nil {
+		logger.Error("Error occurred in writing data to HTTP response", err, nil)
+		return err
+	}
+
+	return nil
+}
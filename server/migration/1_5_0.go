@@ -15,7 +15,7 @@ func upgradeDatabaseToVersion1_5_0(fromVersion string) error {
 
 		defaultTimezone := config.GetConfig().TimeZone
 		for channelID := range channelIDs {
-			standupConfig, err := standup.GetStandupConfig(channelID)
+			standupConfig, err := standup.GetStandupConfig(channelID, standup.DefaultStandupID)
 			if err != nil {
 				return err
 			}
@@ -27,7 +27,7 @@ func upgradeDatabaseToVersion1_5_0(fromVersion string) error {
 			standupConfig.Timezone = defaultTimezone
 			standupConfig.WindowOpenReminderEnabled = true
 			standupConfig.WindowCloseReminderEnabled = true
-			if _, configErr := standup.SaveStandupConfig(standupConfig); configErr != nil {
+			if _, configErr := standup.SaveStandupConfig(standupConfig, ""); configErr != nil {
 				return configErr
 			}
 		}
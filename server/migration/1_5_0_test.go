@@ -39,11 +39,11 @@ func TestUpgradeDatabaseToVersion1_5_0(t *testing.T) {
 		}
 	})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return &standup.Config{}, nil
 	})
 
-	monkey.Patch(standup.SaveStandupConfig, func(standupConfig *standup.Config) (*standup.Config, error) {
+	monkey.Patch(standup.SaveStandupConfig, func(standupConfig *standup.Config, userID string) (*standup.Config, error) {
 		return &standup.Config{}, nil
 	})
 
@@ -93,7 +93,7 @@ func TestUpgradeDatabaseToVersion1_5_0_GetStandupConfig_Error(t *testing.T) {
 		}
 	})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, errors.New("")
 	})
 
@@ -124,11 +124,11 @@ func TestUpgradeDatabaseToVersion1_5_0_SaveStandupConfig_Error(t *testing.T) {
 		}
 	})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return &standup.Config{}, nil
 	})
 
-	monkey.Patch(standup.SaveStandupConfig, func(standupConfig *standup.Config) (*standup.Config, error) {
+	monkey.Patch(standup.SaveStandupConfig, func(standupConfig *standup.Config, userID string) (*standup.Config, error) {
 		return nil, errors.New("")
 	})
 
@@ -159,11 +159,11 @@ func TestUpgradeDatabaseToVersion1_5_0_updateSchemaVersion_Error(t *testing.T) {
 		}
 	})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return &standup.Config{}, nil
 	})
 
-	monkey.Patch(standup.SaveStandupConfig, func(standupConfig *standup.Config) (*standup.Config, error) {
+	monkey.Patch(standup.SaveStandupConfig, func(standupConfig *standup.Config, userID string) (*standup.Config, error) {
 		return &standup.Config{}, nil
 	})
 
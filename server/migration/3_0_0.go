@@ -109,7 +109,7 @@ func getStandupWeekDays(workWeekStart, workWeekEnd int) []string {
 }
 
 func upgradeChannel(channelID, rruleString string) error {
-	channelConfig, err := standup.GetStandupConfig(channelID)
+	channelConfig, err := standup.GetStandupConfig(channelID, standup.DefaultStandupID)
 	if err != nil {
 		return err
 	}
@@ -123,7 +123,7 @@ func upgradeChannel(channelID, rruleString string) error {
 		return err
 	}
 
-	if _, err := standup.SaveStandupConfig(channelConfig); err != nil {
+	if _, err := standup.SaveStandupConfig(channelConfig, ""); err != nil {
 		return err
 	}
 
@@ -1,7 +1,6 @@
 package migration
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -11,26 +10,25 @@ import (
 
 	"github.com/standup-raven/standup-raven/server/config"
 	"github.com/standup-raven/standup-raven/server/logger"
-	"github.com/standup-raven/standup-raven/server/util"
+	"github.com/standup-raven/standup-raven/server/standup"
 )
 
 var (
-	databaseSchemaVersion = "database_schema_version"
-	versionNone           = ""
-	version1_4_0          = "1.4.0"
-	version1_5_0          = "1.5.0"
-	version2_0_0          = "2.0.0"
-	version3_0_0          = "3.0.0"
-	version3_0_1          = "3.0.1"
-	version3_0_2          = "3.0.2"
-	version3_1_0          = "3.1.0"
-	version3_1_1          = "3.1.1"
-	version3_2_0          = "3.2.0"
-	version3_2_1          = "3.2.1"
-	version3_2_2          = "3.2.2"
-	version3_3_0          = "3.3.0"
-	version3_3_1          = "3.3.1"
-	version3_3_2          = "3.3.2"
+	versionNone  = ""
+	version1_4_0 = "1.4.0"
+	version1_5_0 = "1.5.0"
+	version2_0_0 = "2.0.0"
+	version3_0_0 = "3.0.0"
+	version3_0_1 = "3.0.1"
+	version3_0_2 = "3.0.2"
+	version3_1_0 = "3.1.0"
+	version3_1_1 = "3.1.1"
+	version3_2_0 = "3.2.0"
+	version3_2_1 = "3.2.1"
+	version3_2_2 = "3.2.2"
+	version3_3_0 = "3.3.0"
+	version3_3_1 = "3.3.1"
+	version3_3_2 = "3.3.2"
 )
 
 // indicates from what all versions can the plugin
@@ -128,34 +126,11 @@ func DatabaseMigration() error {
 }
 
 func getCurrentSchemaVersion() (string, error) {
-	key, err := config.Mattermost.KVGet(util.GetKeyHash(databaseSchemaVersion))
-	if err != nil {
-		logger.Error("Couldn't fetch database schema version from KV store", err, nil)
-		return "", err
-	}
-	if key != nil {
-		var version string
-		appErr := json.Unmarshal(key, &version)
-		if appErr != nil {
-			logger.Error("Couldn't marshal database schema version", appErr, nil)
-			return "", appErr
-		}
-		return version, nil
-	}
-	return versionNone, nil
+	return standup.GetStore().GetSchemaVersion()
 }
 
 func updateSchemaVersion(version string) error {
-	newVersion, marshalErr := json.Marshal(version)
-	if marshalErr != nil {
-		logger.Error("Couldn't marshal database schema version", marshalErr, nil)
-		return marshalErr
-	}
-	if appErr := config.Mattermost.KVSet(util.GetKeyHash(databaseSchemaVersion), newVersion); appErr != nil {
-		logger.Error("Couldn't update database version into KV store", appErr, nil)
-		return errors.New(appErr.Error())
-	}
-	return nil
+	return standup.GetStore().SetSchemaVersion(version)
 }
 
 func isUpgradeCompatible(fromVersion, toVersion string) bool {
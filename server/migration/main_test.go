@@ -132,7 +132,7 @@ func TestDatabaseMigration_GetStandupConfig_Error(t *testing.T) {
 			"channel_1": "channel_1",
 		}, nil
 	})
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, errors.New("")
 	})
 	err := DatabaseMigration()
@@ -156,7 +156,7 @@ func TestDatabaseMigration_GetStandupConfig_Nil(t *testing.T) {
 			"channel_1": "channel_1",
 		}, nil
 	})
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, nil
 	})
 	err := DatabaseMigration()
@@ -175,7 +175,7 @@ func TestDatabaseMigration_SaveStandupConfig_Error(t *testing.T) {
 			"channel_1": "channel_1",
 		}, nil
 	})
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -196,7 +196,7 @@ func TestDatabaseMigration_SaveStandupConfig_Error(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(standup.SaveStandupConfig, func(standupConfig *standup.Config) (*standup.Config, error) {
+	monkey.Patch(standup.SaveStandupConfig, func(standupConfig *standup.Config, userID string) (*standup.Config, error) {
 		return nil, errors.New("")
 	})
 
@@ -221,7 +221,7 @@ func TestDatabaseMigration_(t *testing.T) {
 			"channel_1": "channel_1",
 		}, nil
 	})
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, nil
 	})
 	err := DatabaseMigration()
@@ -240,7 +240,7 @@ func TestDatabaseMigration_updateSchemaVersion_Error(t *testing.T) {
 			"channel_1": "channel_1",
 		}, nil
 	})
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, nil
 	})
 	monkey.Patch(json.Marshal, func(v interface{}) ([]byte, error) {
@@ -263,7 +263,7 @@ func TestDatabaseMigration_updateSchemaVersion_KVSet_Error(t *testing.T) {
 			"channel_1": "channel_1",
 		}, nil
 	})
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, nil
 	})
 	err := DatabaseMigration()
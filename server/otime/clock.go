@@ -0,0 +1,67 @@
+package otime
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock provides the current time. All scheduling code reads current time through
+// the clock set with SetClock, allowing tests and simulations to control time.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// SystemClock reads the system time. It's the clock used by default.
+var SystemClock Clock = systemClock{}
+
+// clock is only replaced in tests and simulations,
+// so it doesn't need any synchronization.
+var clock = SystemClock
+
+// GetClock returns the clock used for current time.
+func GetClock() Clock {
+	return clock
+}
+
+// SetClock replaces the clock used for current time.
+func SetClock(c Clock) {
+	clock = c
+}
+
+// FakeClock is a clock whose time only changes when it's set or advanced.
+// It's safe for concurrent use.
+type FakeClock struct {
+	mutex sync.RWMutex
+	now   time.Time
+}
+
+// NewFakeClock creates a fake clock set to the specified time.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.now
+}
+
+// Set sets the clock to the specified time.
+func (c *FakeClock) Set(now time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.now = now
+}
+
+// Advance moves the clock forward by the specified duration.
+func (c *FakeClock) Advance(duration time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.now = c.now.Add(duration)
+}
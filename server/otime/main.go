@@ -6,65 +6,63 @@ import (
 	"time"
 )
 
+// OTime is either a calendar date or a time of day, such as standup window times.
+// Times of day are wall-clock times carrying no date or location. They are resolved
+// against a date in the standup timezone only when compared with other times, using On.
 type OTime struct {
 	time.Time
 }
 
 const (
-	layoutTime            = "15:04"
-	layoutTimeWithSeconds = "15:04:05"
-	layoutDate            = "20060102"
-)
-
-var nilTime = (time.Time{}).UnixNano()
+	layoutTime = "15:04"
+	layoutDate = "20060102"
 
-// DefaultLocation is the default timezone to be used when creating a new standup config.
-// TODO will need to remove this and use channel-specific location
-// when adding per-channel timezone setting
-var DefaultLocation *time.Location
+	// LayoutISODate is the date format accepted and returned by HTTP APIs.
+	LayoutISODate = "2006-01-02"
+)
 
+// Parse parses a wall-clock time of day in "15:04" format.
 func Parse(value string) (OTime, error) {
 	argTime, err := time.Parse(layoutTime, value)
 	if err != nil {
 		return OTime{}, err
 	}
 
-	now := time.Now()
-	argTime = time.Date(now.Year(), now.Month(), now.Day(), argTime.Hour(), argTime.Minute(), 0, 0, DefaultLocation)
 	return OTime{argTime}, nil
 }
 
-func Now(timezone string) OTime {
-	now := time.Now()
-	location, _ := time.LoadLocation(timezone)
-	return OTime{now.In(location)}
+// ParseDate parses a date in LayoutISODate format as midnight of that day in the specified timezone.
+func ParseDate(value, timezone string) (OTime, error) {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return OTime{}, err
+	}
+
+	date, err := time.ParseInLocation(LayoutISODate, value, location)
+	if err != nil {
+		return OTime{}, err
+	}
+
+	return OTime{date}, nil
 }
 
-// GetTime returns time with format like "15:04"
-func (ct OTime) GetTime(timezone string) OTime {
-	now, _ := time.Parse(layoutTime, ct.Format(layoutTime))
+// Now returns current time of the clock in the specified timezone.
+func Now(timezone string) OTime {
+	now := clock.Now()
 	location, _ := time.LoadLocation(timezone)
 	return OTime{now.In(location)}
 }
 
-// GetTimeWithSeconds returns time with format like "15:04:05"
-func (ct OTime) GetTimeWithSeconds(timezone string) OTime {
-	now, _ := time.Parse(layoutTimeWithSeconds, ct.Format(layoutTimeWithSeconds))
-	location, _ := time.LoadLocation(timezone)
-	return OTime{now.In(location)}
+// On returns the time of day on the calendar date of the specified time, in its location.
+// Times of day skipped by daylight saving time changes are normalized the way time.Date does.
+func (ct OTime) On(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), ct.Hour(), ct.Minute(), ct.Second(), 0, date.Location())
 }
 
 func (ct OTime) GetTimeString() string {
 	return ct.Time.Format(layoutTime)
 }
 
-// GetDate returns date with format like "20060102"
-func (ct OTime) GetDate(timezone string) OTime {
-	now, _ := time.Parse(layoutDate, ct.Format(layoutDate))
-	location, _ := time.LoadLocation(timezone)
-	return OTime{now.In(location)}
-}
-
 func (ct OTime) GetDateString() string {
 	return ct.Time.Format(layoutDate)
 }
@@ -85,7 +83,7 @@ func (ct *OTime) UnmarshalJSON(b []byte) (err error) {
 }
 
 func (ct OTime) MarshalJSON() ([]byte, error) {
-	if ct.Time.UnixNano() == nilTime {
+	if ct.Time.IsZero() {
 		return []byte("null"), nil
 	}
 	return []byte(fmt.Sprintf("\"%s\"", ct.Time.Format(layoutTime))), nil
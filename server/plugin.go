@@ -10,6 +10,7 @@ import (
 
 	"github.com/standup-raven/standup-raven/server/logger"
 	"github.com/standup-raven/standup-raven/server/migration"
+	"github.com/standup-raven/standup-raven/server/standup"
 	"github.com/standup-raven/standup-raven/server/standup/notification"
 
 	"os"
@@ -33,8 +34,9 @@ var EncodedPluginIcon string
 
 type Plugin struct {
 	plugin.MattermostPlugin
-	handler http.Handler
-	job     *cluster.Job
+	handler  http.Handler
+	job      *cluster.Job
+	purgeJob *cluster.Job
 }
 
 func (p *Plugin) OnActivate() error {
@@ -227,6 +229,12 @@ func (p *Plugin) Run() error {
 		}
 	}
 
+	if p.purgeJob != nil {
+		if err := p.purgeJob.Close(); err != nil {
+			return err
+		}
+	}
+
 	job, err := cluster.Schedule(
 		config.Mattermost,
 		"StandupRavenReportScheduler",
@@ -244,6 +252,24 @@ func (p *Plugin) Run() error {
 	}
 
 	p.job = job
+
+	purgeJob, err := cluster.Schedule(
+		config.Mattermost,
+		"StandupRavenDataPurgeScheduler",
+		cluster.MakeWaitForInterval(config.DataPurgeInterval),
+		func() {
+			if _, err := standup.PurgeExpiredData(); err != nil {
+				logger.Error("Failed to purge expired standup data. Error: "+err.Error(), err, nil)
+			}
+		},
+	)
+
+	if err != nil {
+		p.API.LogError(fmt.Sprintf("Unable to schedule job for purging standup data. Error: {%s}", err.Error()))
+		return err
+	}
+
+	p.purgeJob = purgeJob
 	return nil
 }
 
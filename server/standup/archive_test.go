@@ -0,0 +1,69 @@
+package standup
+
+import (
+	"testing"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestoreArchivedStandupConfig(t *testing.T) {
+	defer TearDown()
+	mockAPI := baseMock()
+	mockAPI.On("GetChannel", "channel_id").Return(&model.Channel{}, nil)
+	mockAPI.On("UpdateChannel", mock.Anything).Return(nil, nil)
+
+	SetStore(NewMemoryStore())
+	defer SetStore(&KVStore{})
+
+	// nothing archived yet
+	_, err := RestoreArchivedStandupConfig("channel_id", "user_id_1")
+	assert.NotNil(t, err)
+
+	standupConfig := configHistoryTestConfig("section_1")
+	assert.Nil(t, standupConfig.PreSave())
+	_, err = SaveStandupConfig(standupConfig, "user_id_1")
+	assert.Nil(t, err)
+
+	assert.Nil(t, ArchiveStandupChannels("channel_id"))
+
+	// active config can't be overwritten by an archived one
+	_, err = SaveStandupConfig(standupConfig, "user_id_1")
+	assert.Nil(t, err)
+	_, err = RestoreArchivedStandupConfig("channel_id", "user_id_1")
+	assert.NotNil(t, err)
+	assert.Nil(t, GetStore().ArchiveStandupConfig("channel_id"))
+
+	archivedConfigs, err := GetArchivedStandupConfigs()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(archivedConfigs))
+	assert.Equal(t, "channel_id", archivedConfigs[0].ChannelID)
+
+	restoredConfig, err := RestoreArchivedStandupConfig("channel_id", "user_id_2")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"section_1"}, restoredConfig.Sections)
+
+	currentConfig, err := GetStandupConfig("channel_id", "")
+	assert.Nil(t, err)
+	assert.NotNil(t, currentConfig)
+
+	channels, err := GetStandupChannels()
+	assert.Nil(t, err)
+	assert.Contains(t, channels, "channel_id")
+
+	archivedConfigs, err = GetArchivedStandupConfigs()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(archivedConfigs), "archived copy should be removed after restoring")
+
+	history, err := GetStandupConfigHistory("channel_id", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "user_id_2", history[len(history)-1].UserID)
+
+	// archived config that no longer passes validation
+	invalidConfig := configHistoryTestConfig()
+	assert.Nil(t, GetStore().SaveStandupConfig(invalidConfig))
+	assert.Nil(t, ArchiveStandupChannels("channel_id"))
+	_, err = RestoreArchivedStandupConfig("channel_id", "user_id_2")
+	assert.NotNil(t, err)
+}
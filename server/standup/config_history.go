@@ -0,0 +1,104 @@
+package standup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/standup-raven/standup-raven/server/logger"
+	"github.com/standup-raven/standup-raven/server/otime"
+)
+
+// configHistoryMaxLength is the number of most recent
+// config versions retained for each channel.
+const configHistoryMaxLength = 20
+
+// ConfigVersion is a snapshot of channel standup config
+// recorded every time the config is saved.
+type ConfigVersion struct {
+	Version   int       `json:"version"`
+	UserID    string    `json:"userId"`
+	CreatedAt time.Time `json:"createdAt"`
+	Config    *Config   `json:"config"`
+}
+
+// GetStandupConfigHistory fetches all recorded versions of
+// config of the channel standup, oldest first.
+func GetStandupConfigHistory(channelID, standupID string) ([]*ConfigVersion, error) {
+	logger.Debug(fmt.Sprintf("Fetching standup config history for channel: %s, standup: %s", channelID, standupID), nil)
+	return store.GetStandupConfigHistory(channelID, standupID)
+}
+
+// RestoreStandupConfigVersion restores the specified version of channel standup config
+// and adds the standup back to the list of standup channels in case it was removed.
+// The restored config is saved as a new version authored by the specified user.
+func RestoreStandupConfigVersion(channelID, standupID string, version int, userID string) (*Config, error) {
+	logger.Debug(fmt.Sprintf("Restoring standup config version %d for channel: %s, standup: %s", version, channelID, standupID), nil)
+
+	history, err := GetStandupConfigHistory(channelID, standupID)
+	if err != nil {
+		return nil, err
+	}
+
+	var configVersion *ConfigVersion
+	for _, v := range history {
+		if v.Version == version {
+			configVersion = v
+			break
+		}
+	}
+
+	if configVersion == nil || configVersion.Config == nil {
+		return nil, fmt.Errorf("no standup config version %d found for this channel", version)
+	}
+
+	standupConfig := configVersion.Config
+	if standupConfig.ChannelID != channelID || standupConfig.StandupID != standupID {
+		return nil, fmt.Errorf("standup config version %d doesn't belong to this channel", version)
+	}
+
+	if err := standupConfig.PreSave(); err != nil {
+		return nil, err
+	}
+
+	if err := standupConfig.IsValid(); err != nil {
+		return nil, err
+	}
+
+	restoredConfig, err := SaveStandupConfig(standupConfig, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := AddStandupChannel(channelID, standupID); err != nil {
+		return nil, err
+	}
+
+	return restoredConfig, nil
+}
+
+// addStandupConfigVersion records the config as the latest version in channel's config history.
+// Only the most recent configHistoryMaxLength versions are retained.
+func addStandupConfigVersion(standupConfig *Config, userID string) error {
+	history, err := store.GetStandupConfigHistory(standupConfig.ChannelID, standupConfig.StandupID)
+	if err != nil {
+		return err
+	}
+
+	version := 1
+	if len(history) > 0 {
+		version = history[len(history)-1].Version + 1
+	}
+
+	history = append(history, &ConfigVersion{
+		Version:   version,
+		UserID:    userID,
+		CreatedAt: otime.GetClock().Now(),
+		Config:    standupConfig,
+	})
+
+	if len(history) > configHistoryMaxLength {
+		history = history[len(history)-configHistoryMaxLength:]
+	}
+
+	return store.SetStandupConfigHistory(standupConfig.ChannelID, standupConfig.StandupID, history)
+}
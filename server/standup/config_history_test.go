@@ -0,0 +1,109 @@
+package standup
+
+import (
+	"testing"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest/mock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/otime"
+)
+
+func configHistoryTestConfig(sections ...string) *Config {
+	windowOpenTime, _ := otime.Parse("10:00")
+	windowCloseTime, _ := otime.Parse("11:00")
+
+	return &Config{
+		ChannelID:       "channel_id",
+		WindowOpenTime:  windowOpenTime,
+		WindowCloseTime: windowCloseTime,
+		Enabled:         true,
+		Members:         []string{"user_id_1"},
+		ReportFormat:    config.ReportFormatUserAggregated,
+		Sections:        sections,
+		Timezone:        "Asia/Kolkata",
+		RRuleString:     "FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,TU,WE,TH,FR",
+	}
+}
+
+func TestSaveStandupConfig_RecordsHistory(t *testing.T) {
+	defer TearDown()
+	mockAPI := baseMock()
+	mockAPI.On("GetChannel", "channel_id").Return(&model.Channel{}, nil)
+	mockAPI.On("UpdateChannel", mock.Anything).Return(nil, nil)
+
+	SetStore(NewMemoryStore())
+	defer SetStore(&KVStore{})
+
+	for i := 0; i < configHistoryMaxLength+2; i++ {
+		standupConfig := configHistoryTestConfig("section_1")
+		assert.Nil(t, standupConfig.PreSave())
+		_, err := SaveStandupConfig(standupConfig, "user_id_1")
+		assert.Nil(t, err)
+	}
+
+	history, err := GetStandupConfigHistory("channel_id", "")
+	assert.Nil(t, err)
+	assert.Equal(t, configHistoryMaxLength, len(history), "only the most recent versions should be retained")
+	assert.Equal(t, 3, history[0].Version)
+	assert.Equal(t, configHistoryMaxLength+2, history[len(history)-1].Version)
+	assert.Equal(t, "user_id_1", history[0].UserID)
+	assert.False(t, history[0].CreatedAt.IsZero())
+
+	history, err = GetStandupConfigHistory("channel_without_history", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(history))
+}
+
+func TestRestoreStandupConfigVersion(t *testing.T) {
+	defer TearDown()
+	mockAPI := baseMock()
+	mockAPI.On("GetChannel", "channel_id").Return(&model.Channel{}, nil)
+	mockAPI.On("UpdateChannel", mock.Anything).Return(nil, nil)
+
+	SetStore(NewMemoryStore())
+	defer SetStore(&KVStore{})
+
+	standupConfig := configHistoryTestConfig("section_1", "section_2")
+	assert.Nil(t, standupConfig.PreSave())
+	_, err := SaveStandupConfig(standupConfig, "user_id_1")
+	assert.Nil(t, err)
+
+	// accidentally wiping all sections
+	standupConfig = configHistoryTestConfig()
+	assert.Nil(t, standupConfig.PreSave())
+	_, err = SaveStandupConfig(standupConfig, "user_id_2")
+	assert.Nil(t, err)
+
+	restoredConfig, err := RestoreStandupConfigVersion("channel_id", "", 1, "user_id_3")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"section_1", "section_2"}, restoredConfig.Sections)
+
+	currentConfig, err := GetStandupConfig("channel_id", "")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"section_1", "section_2"}, currentConfig.Sections)
+
+	history, err := GetStandupConfigHistory("channel_id", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(history), "restoring should record a new version")
+	assert.Equal(t, "user_id_3", history[2].UserID)
+
+	// version 2 has no sections and fails validation
+	_, err = RestoreStandupConfigVersion("channel_id", "", 2, "user_id_3")
+	assert.NotNil(t, err)
+
+	// non-existing version
+	_, err = RestoreStandupConfigVersion("channel_id", "", 10, "user_id_3")
+	assert.NotNil(t, err)
+
+	// restoring a removed standup schedules it again
+	assert.Nil(t, RemoveStandupChannels([]string{"channel_id"}))
+	_, err = RestoreStandupConfigVersion("channel_id", "", 1, "user_id_3")
+	assert.Nil(t, err)
+
+	channels, err := GetStandupChannels()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"channel_id": "channel_id"}, channels)
+}
@@ -0,0 +1,172 @@
+package standup
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/logger"
+	"github.com/standup-raven/standup-raven/server/otime"
+)
+
+const (
+	ExportFormatCSV  = "csv"
+	ExportFormatJSON = "json"
+)
+
+var (
+	ExportFormats = []string{ExportFormatCSV, ExportFormatJSON}
+
+	exportCSVHeader = []string{"date", "user", "section", "text"}
+)
+
+// ExportRow is a single task of a user standup.
+type ExportRow struct {
+	Date    string `json:"date"`
+	User    string `json:"user"`
+	Section string `json:"section"`
+	Text    string `json:"text"`
+}
+
+// GetStandupExport collects standups of all channel standup members submitted
+// between the specified dates, both inclusive, with one row per task.
+func GetStandupExport(channelID, standupID string, from, to otime.OTime) ([]*ExportRow, error) {
+	if err := ValidateStandupHistoryRange(from, to); err != nil {
+		return nil, err
+	}
+
+	logger.Debug(fmt.Sprintf("Exporting standups for channel: %s from: %s to: %s", channelID, from.GetDateString(), to.GetDateString()), nil)
+
+	standupConfig, err := GetStandupConfig(channelID, standupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if standupConfig == nil {
+		return nil, errors.New("standup not configured for channel: " + channelID)
+	}
+
+	usernames := map[string]string{}
+	for _, userID := range standupConfig.Members {
+		user, appErr := config.Mattermost.GetUser(userID)
+		if appErr != nil {
+			// user may have been deleted since, so not failing the whole export
+			logger.Error("Couldn't fetch user", appErr, map[string]interface{}{"userID": userID})
+			usernames[userID] = userID
+			continue
+		}
+		usernames[userID] = user.Username
+	}
+
+	rows := []*ExportRow{}
+	for date := from; !date.After(to.Time); date = (otime.OTime{Time: date.AddDate(0, 0, 1)}) {
+		for _, userID := range standupConfig.Members {
+			userStandup, err := GetUserStandup(userID, channelID, standupID, date)
+			if err != nil {
+				return nil, err
+			}
+
+			if userStandup == nil {
+				continue
+			}
+
+			for _, section := range getUserStandupSections(userStandup, standupConfig.Sections) {
+				tasks := userStandup.Standup[section]
+				if tasks == nil {
+					continue
+				}
+
+				for _, task := range *tasks {
+					rows = append(rows, &ExportRow{
+						Date:    date.Format(otime.LayoutISODate),
+						User:    usernames[userID],
+						Section: section,
+						Text:    task,
+					})
+				}
+			}
+		}
+	}
+
+	return rows, nil
+}
+
+// getUserStandupSections returns sections of the user standup in the order they're configured in.
+// Sections no longer part of standup config follow, sorted by name.
+func getUserStandupSections(userStandup *UserStandup, configuredSections []string) []string {
+	sections := []string{}
+	configured := map[string]bool{}
+
+	for _, section := range configuredSections {
+		configured[section] = true
+		if _, ok := userStandup.Standup[section]; ok {
+			sections = append(sections, section)
+		}
+	}
+
+	extraSections := []string{}
+	for section := range userStandup.Standup {
+		if !configured[section] {
+			extraSections = append(extraSections, section)
+		}
+	}
+	sort.Strings(extraSections)
+
+	return append(sections, extraSections...)
+}
+
+// EncodeStandupExport serializes export rows in the specified format.
+func EncodeStandupExport(rows []*ExportRow, format string) ([]byte, error) {
+	switch format {
+	case ExportFormatJSON:
+		return json.Marshal(rows)
+	case ExportFormatCSV:
+		buffer := &bytes.Buffer{}
+		writer := csv.NewWriter(buffer)
+
+		if err := writer.Write(exportCSVHeader); err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			record := []string{row.Date, escapeCSVCell(row.User), escapeCSVCell(row.Section), escapeCSVCell(row.Text)}
+			if err := writer.Write(record); err != nil {
+				return nil, err
+			}
+		}
+
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return nil, err
+		}
+
+		return buffer.Bytes(), nil
+	default:
+		return nil, errors.New("invalid export format: " + format)
+	}
+}
+
+// escapeCSVCell prefixes the value with a quote if it starts with a character spreadsheet
+// applications treat as the start of a formula, so user input is never evaluated as one.
+func escapeCSVCell(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
+
+// GetStandupExportFileName generates file name for standup export of the channel standup.
+func GetStandupExportFileName(channelName, standupID string, from, to otime.OTime, format string) string {
+	name := channelName
+	if standupID != DefaultStandupID {
+		name += "_" + standupID
+	}
+
+	return fmt.Sprintf("standup_%s_%s_%s.%s", name, from.Format(otime.LayoutISODate), to.Format(otime.LayoutISODate), format)
+}
@@ -0,0 +1,104 @@
+package standup
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/standup-raven/standup-raven/server/otime"
+)
+
+func TestGetStandupExport(t *testing.T) {
+	defer TearDown()
+	mockAPI := baseMock()
+	mockAPI.On("GetUser", "user_id_1").Return(&model.User{Username: "john"}, nil)
+	mockAPI.On("GetUser", "user_id_2").Return(nil, model.NewAppError("", "", nil, "", 404))
+
+	SetStore(NewMemoryStore())
+	defer SetStore(&KVStore{})
+
+	standupConfig := configHistoryTestConfig("section_1", "section_2")
+	standupConfig.Members = []string{"user_id_1", "user_id_2"}
+	assert.Nil(t, GetStore().SaveStandupConfig(standupConfig))
+
+	assert.Nil(t, GetStore().SaveUserStandup("20201001", &UserStandup{
+		UserID:    "user_id_1",
+		ChannelID: "channel_id",
+		Standup: map[string]*[]string{
+			"section_2":       {"task_3"},
+			"section_1":       {"task_1", "task, \"quoted\""},
+			"removed_section": {"task_4"},
+		},
+	}))
+
+	assert.Nil(t, GetStore().SaveUserStandup("20201003", &UserStandup{
+		UserID:    "user_id_2",
+		ChannelID: "channel_id",
+		Standup: map[string]*[]string{
+			"section_1": {"task_5"},
+		},
+	}))
+
+	// outside export range
+	assert.Nil(t, GetStore().SaveUserStandup("20201004", &UserStandup{
+		UserID:    "user_id_1",
+		ChannelID: "channel_id",
+		Standup: map[string]*[]string{
+			"section_1": {"task_6"},
+		},
+	}))
+
+	from, _ := otime.ParseDate("2020-10-01", "Asia/Kolkata")
+	to, _ := otime.ParseDate("2020-10-03", "Asia/Kolkata")
+
+	rows, err := GetStandupExport("channel_id", "", from, to)
+	assert.Nil(t, err)
+	assert.Equal(t, []*ExportRow{
+		{Date: "2020-10-01", User: "john", Section: "section_1", Text: "task_1"},
+		{Date: "2020-10-01", User: "john", Section: "section_1", Text: "task, \"quoted\""},
+		{Date: "2020-10-01", User: "john", Section: "section_2", Text: "task_3"},
+		{Date: "2020-10-01", User: "john", Section: "removed_section", Text: "task_4"},
+		{Date: "2020-10-03", User: "user_id_2", Section: "section_1", Text: "task_5"},
+	}, rows)
+
+	data, err := EncodeStandupExport(rows, ExportFormatCSV)
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	assert.Equal(t, 6, len(lines))
+	assert.Equal(t, "date,user,section,text", lines[0])
+	assert.Equal(t, "2020-10-01,john,section_1,\"task, \"\"quoted\"\"\"", lines[2])
+
+	// cells which could be evaluated as formulas are escaped
+	data, err = EncodeStandupExport([]*ExportRow{
+		{Date: "2020-10-01", User: "@john", Section: "+section", Text: "=HYPERLINK(\"http://example.com\")"},
+		{Date: "2020-10-01", User: "john", Section: "section_1", Text: "-1"},
+		{Date: "2020-10-01", User: "john", Section: "section_1", Text: "\tTAB"},
+		{Date: "2020-10-01", User: "john", Section: "section_1", Text: "total = 1 + 2"},
+	}, ExportFormatCSV)
+	assert.Nil(t, err)
+	lines = strings.Split(strings.TrimSpace(string(data)), "\n")
+	assert.Equal(t, "2020-10-01,'@john,'+section,\"'=HYPERLINK(\"\"http://example.com\"\")\"", lines[1])
+	assert.Equal(t, "2020-10-01,john,section_1,'-1", lines[2])
+	assert.Equal(t, "2020-10-01,john,section_1,'\tTAB", lines[3])
+	assert.Equal(t, "2020-10-01,john,section_1,total = 1 + 2", lines[4])
+
+	data, err = EncodeStandupExport(rows, ExportFormatJSON)
+	assert.Nil(t, err)
+	decodedRows := []*ExportRow{}
+	assert.Nil(t, json.Unmarshal(data, &decodedRows))
+	assert.Equal(t, rows, decodedRows)
+
+	_, err = EncodeStandupExport(rows, "xml")
+	assert.NotNil(t, err)
+
+	// end date before start date
+	_, err = GetStandupExport("channel_id", "", to, from)
+	assert.NotNil(t, err)
+
+	// standup not configured
+	_, err = GetStandupExport("other_channel_id", "", from, to)
+	assert.NotNil(t, err)
+}
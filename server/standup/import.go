@@ -0,0 +1,119 @@
+package standup
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/standup-raven/standup-raven/server/logger"
+	"github.com/standup-raven/standup-raven/server/otime"
+)
+
+// ImportData is a document of standup configs and user standups to import,
+// usually when moving over from another tool.
+type ImportData struct {
+	Configs  []*Config           `json:"configs"`
+	Standups []*DatedUserStandup `json:"standups"`
+}
+
+// ImportItemResult is the outcome of importing a single item of ImportData.
+// Error is empty if the item was imported successfully.
+type ImportItemResult struct {
+	Index     int    `json:"index"`
+	ChannelID string `json:"channelId"`
+	UserID    string `json:"userId,omitempty"`
+	Date      string `json:"date,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// ImportResult lists the outcome of importing each item of ImportData, in the same order.
+type ImportResult struct {
+	Configs  []*ImportItemResult `json:"configs"`
+	Standups []*ImportItemResult `json:"standups"`
+}
+
+// Import validates and saves all configs and then all user standups of the document.
+// Invalid items are skipped without affecting the rest of the import.
+func Import(data *ImportData, userID string) *ImportResult {
+	logger.Debug(fmt.Sprintf("Importing %d standup configs and %d user standups", len(data.Configs), len(data.Standups)), nil)
+
+	result := &ImportResult{
+		Configs:  make([]*ImportItemResult, len(data.Configs)),
+		Standups: make([]*ImportItemResult, len(data.Standups)),
+	}
+
+	for i, standupConfig := range data.Configs {
+		itemResult := &ImportItemResult{Index: i}
+		if standupConfig != nil {
+			itemResult.ChannelID = standupConfig.ChannelID
+		}
+
+		if err := importStandupConfig(standupConfig, userID); err != nil {
+			itemResult.Error = err.Error()
+		}
+
+		result.Configs[i] = itemResult
+	}
+
+	for i, userStandup := range data.Standups {
+		itemResult := &ImportItemResult{Index: i}
+		if userStandup != nil && userStandup.UserStandup != nil {
+			itemResult.ChannelID = userStandup.ChannelID
+			itemResult.UserID = userStandup.UserID
+			itemResult.Date = userStandup.Date
+		}
+
+		if err := importUserStandup(userStandup); err != nil {
+			itemResult.Error = err.Error()
+		}
+
+		result.Standups[i] = itemResult
+	}
+
+	return result
+}
+
+func importStandupConfig(standupConfig *Config, userID string) error {
+	if standupConfig == nil {
+		return errors.New("empty standup config")
+	}
+
+	if err := standupConfig.PreSave(); err != nil {
+		return err
+	}
+
+	if err := standupConfig.IsValid(); err != nil {
+		return err
+	}
+
+	if _, err := SaveStandupConfig(standupConfig, userID); err != nil {
+		return err
+	}
+
+	return AddStandupChannel(standupConfig.ChannelID, standupConfig.StandupID)
+}
+
+func importUserStandup(userStandup *DatedUserStandup) error {
+	if userStandup == nil || userStandup.UserStandup == nil {
+		return errors.New("empty user standup")
+	}
+
+	if err := userStandup.IsValid(); err != nil {
+		return err
+	}
+
+	standupConfig, err := GetStandupConfig(userStandup.ChannelID, userStandup.StandupID)
+	if err != nil {
+		return err
+	}
+
+	if standupConfig == nil {
+		return errors.New("standup not configured for channel: " + userStandup.ChannelID)
+	}
+
+	date, err := otime.ParseDate(userStandup.Date, standupConfig.Timezone)
+	if err != nil {
+		return fmt.Errorf("invalid date \"%s\". Dates must be in YYYY-MM-DD format", userStandup.Date)
+	}
+
+	return SaveUserStandupForDate(userStandup.UserStandup, date)
+}
@@ -0,0 +1,92 @@
+package standup
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest/mock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/standup-raven/standup-raven/server/otime"
+)
+
+func TestImport(t *testing.T) {
+	defer TearDown()
+	mockAPI := baseMock()
+	mockAPI.On("GetChannel", "channel_id").Return(&model.Channel{}, nil)
+	mockAPI.On("GetChannel", "unconfigured_channel_id").Return(&model.Channel{}, nil)
+	mockAPI.On("UpdateChannel", mock.Anything).Return(nil, nil)
+
+	SetStore(NewMemoryStore())
+	defer SetStore(&KVStore{})
+
+	document := `{
+		"configs": [
+			{
+				"channelId": "channel_id",
+				"windowOpenTime": "10:00",
+				"windowCloseTime": "11:00",
+				"enabled": true,
+				"members": ["user_id_1"],
+				"reportFormat": "user_aggregated",
+				"sections": ["section_1"],
+				"timezone": "Asia/Kolkata",
+				"rruleString": "FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,TU,WE,TH,FR"
+			},
+			{
+				"channelId": "unconfigured_channel_id",
+				"windowOpenTime": "10:00",
+				"windowCloseTime": "11:00",
+				"reportFormat": "user_aggregated",
+				"sections": [],
+				"timezone": "Asia/Kolkata",
+				"rruleString": "FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,TU,WE,TH,FR"
+			}
+		],
+		"standups": [
+			{"date": "2020-10-01", "userId": "user_id_1", "channelId": "channel_id", "standup": {"section_1": ["task_1"]}},
+			{"date": "01-10-2020", "userId": "user_id_1", "channelId": "channel_id", "standup": {"section_1": ["task_1"]}},
+			{"date": "2020-10-01", "userId": "user_id_1", "channelId": "channel_id", "standup": {"section_1": []}},
+			{"date": "2020-10-01", "userId": "user_id_1", "channelId": "unconfigured_channel_id", "standup": {"section_1": ["task_1"]}},
+			null
+		]
+	}`
+
+	data := &ImportData{}
+	assert.Nil(t, json.Unmarshal([]byte(document), data))
+
+	result := Import(data, "admin_user_id")
+
+	assert.Equal(t, 2, len(result.Configs))
+	assert.Equal(t, "", result.Configs[0].Error)
+	assert.NotEqual(t, "", result.Configs[1].Error, "config without sections should be rejected")
+	assert.Equal(t, 1, result.Configs[1].Index)
+	assert.Equal(t, "unconfigured_channel_id", result.Configs[1].ChannelID)
+
+	assert.Equal(t, 5, len(result.Standups))
+	assert.Equal(t, "", result.Standups[0].Error)
+	assert.Equal(t, "2020-10-01", result.Standups[0].Date)
+	assert.NotEqual(t, "", result.Standups[1].Error, "invalid date should be rejected")
+	assert.NotEqual(t, "", result.Standups[2].Error, "standup without tasks should be rejected")
+	assert.NotEqual(t, "", result.Standups[3].Error, "standup of channel without config should be rejected")
+	assert.NotEqual(t, "", result.Standups[4].Error)
+
+	standupConfig, err := GetStandupConfig("channel_id", "")
+	assert.Nil(t, err)
+	assert.NotNil(t, standupConfig)
+
+	channels, err := GetStandupChannels()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"channel_id": "channel_id"}, channels)
+
+	history, err := GetStandupConfigHistory("channel_id", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "admin_user_id", history[0].UserID)
+
+	date, _ := otime.ParseDate("2020-10-01", "Asia/Kolkata")
+	userStandup, err := GetUserStandup("user_id_1", "channel_id", "", date)
+	assert.Nil(t, err)
+	assert.NotNil(t, userStandup)
+	assert.Equal(t, []string{"task_1"}, *userStandup.Standup["section_1"])
+}
@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/dustin/go-humanize"
+	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/teambition/rrule-go"
 
 	"github.com/thoas/go-funk"
@@ -23,10 +25,28 @@ const (
 	standupSectionsMinLength       = 1
 	channelHeaderScheduleSeparator = "|"
 	standupScheduleEndMarker       = "** **"
+
+	// StandupHistoryMaxDays is the maximum number of days
+	// that can be fetched in a single standup history query.
+	StandupHistoryMaxDays = 92
+
+	// UpcomingStandupsDefaultCount and UpcomingStandupsMaxCount are the default
+	// and maximum number of upcoming standup dates previewed at once.
+	UpcomingStandupsDefaultCount = 5
+	UpcomingStandupsMaxCount     = 50
+
+	// DefaultStandupID identifies the standup every channel starts with.
+	// Additional standups in a channel are identified by a user specified ID.
+	DefaultStandupID = ""
+
+	standupIDMaxLength = 32
 )
 
 var (
-	standupScheduleRegex = regexp.MustCompile(`^\*\*Standup Schedule\*\*: .+\*\* \*\*$`)
+	// the capturing group is the standup ID, empty for the default standup
+	standupScheduleRegex = regexp.MustCompile(`^\*\*Standup Schedule(?: \(([a-z0-9_-]+)\))?\*\*: .+\*\* \*\*$`)
+	standupIDRegex       = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+	supportedFrequencies = []rrule.Frequency{rrule.DAILY, rrule.WEEKLY, rrule.MONTHLY, rrule.YEARLY}
 	weekRanks            = map[int]string{
 		-1: "last",
 		1:  "first",
@@ -40,6 +60,13 @@ type UserStandup struct {
 	Standup   map[string]*[]string `json:"standup"`
 	UserID    string               `json:"userId"`
 	ChannelID string               `json:"channelId"`
+	StandupID string               `json:"standupId,omitempty"`
+}
+
+// DatedUserStandup is a user standup along with the date it was submitted for.
+type DatedUserStandup struct {
+	Date string `json:"date"`
+	*UserStandup
 }
 
 func (us *UserStandup) IsValid() error {
@@ -51,6 +78,10 @@ func (us *UserStandup) IsValid() error {
 		return errors.New("no channels ID specified in standup")
 	}
 
+	if err := ValidateStandupID(us.StandupID); err != nil {
+		return err
+	}
+
 	if _, err := config.Mattermost.GetChannel(us.ChannelID); err != nil {
 		return errors.New("No channel found with channel ID: " + us.ChannelID)
 	}
@@ -75,6 +106,7 @@ type Config struct {
 	Sections                   []string     `json:"sections"`
 	Members                    []string     `json:"members"`
 	ChannelID                  string       `json:"channelId"`
+	StandupID                  string       `json:"standupId,omitempty"`
 	ReportFormat               string       `json:"reportFormat"`
 	Timezone                   string       `json:"timezone"`
 	RRuleString                string       `json:"rruleString"`
@@ -82,6 +114,44 @@ type Config struct {
 	WindowOpenReminderEnabled  bool         `json:"windowOpenReminderEnabled"`
 	WindowCloseReminderEnabled bool         `json:"windowCloseReminderEnabled"`
 	ScheduleEnabled            bool         `json:"scheduleEnabled"`
+
+	// MemberTimezonesEnabled makes window times apply in each member's
+	// profile timezone instead of the standup timezone.
+	MemberTimezonesEnabled bool `json:"memberTimezonesEnabled"`
+
+	// ExDates are dates, in YYYY-MM-DD format, skipped from standup's recurrence rule
+	// and RDates are additional dates standup is held on. These are the EXDATE and
+	// RDATE exceptions of standup's schedule. See ScheduleSet.
+	ExDates []string `json:"exDates"`
+	RDates  []string `json:"rDates"`
+
+	// Reminders sent during standup window. The window open reminder is sent if
+	// WindowOpenReminderEnabled is set and reminders to members yet to fill their standup
+	// are sent if WindowCloseReminderEnabled is set. See ReminderSchedule for defaults.
+	Reminders []Reminder `json:"reminders"`
+
+	// DirectMessageReminders sends reminders as direct messages from the bot
+	// to each member yet to fill their standup, instead of posting them in the channel.
+	DirectMessageReminders bool `json:"directMessageReminders"`
+
+	// WindowOpenMessage and WindowCloseMessage are text/template templates of the window open
+	// reminder and of reminders to members yet to fill their standup, rendered with
+	// ReminderMessageData. Built-in messages are sent if these are empty.
+	WindowOpenMessage  string `json:"windowOpenMessage,omitempty"`
+	WindowCloseMessage string `json:"windowCloseMessage,omitempty"`
+
+	// ReportTemplate is the text/template template of standup report, rendered with Report.
+	// It's used by, and required for, the custom report format.
+	ReportTemplate string `json:"reportTemplate,omitempty"`
+
+	// UpdateReportPost regenerates the standup report post when members fill or update their
+	// standup after the report is posted, instead of posting their standup as a reply to it.
+	UpdateReportPost bool `json:"updateReportPost"`
+
+	// ReportChannels are IDs of other channels a copy of standup report is posted in
+	// and ReportUsers are IDs of users it's sent to as a direct message.
+	ReportChannels []string `json:"reportChannels"`
+	ReportUsers    []string `json:"reportUsers"`
 }
 
 func (sc *Config) IsValid() error {
@@ -89,6 +159,10 @@ func (sc *Config) IsValid() error {
 		return errors.New("channel ID cannot be empty")
 	}
 
+	if err := ValidateStandupID(sc.StandupID); err != nil {
+		return err
+	}
+
 	emptyTime := otime.OTime{}
 
 	if sc.WindowOpenTime == emptyTime {
@@ -99,8 +173,8 @@ func (sc *Config) IsValid() error {
 		return errors.New("window close time cannot be empty")
 	}
 
-	if sc.WindowOpenTime.Time.After(sc.WindowCloseTime.Time) {
-		return errors.New("window open time cannot be after window close time")
+	if sc.WindowOpenTime.GetTimeString() == sc.WindowCloseTime.GetTimeString() {
+		return errors.New("window open time cannot be same as window close time")
 	}
 
 	if sc.Timezone == "" {
@@ -128,10 +202,42 @@ func (sc *Config) IsValid() error {
 		return errors.New("Duplicate members are not allowed. Contains duplicate member '" + duplicateMember + "'")
 	}
 
+	if !funk.Contains(supportedFrequencies, sc.RRule.Freq) {
+		return errors.New("standup can only repeat daily, weekly, monthly or yearly")
+	}
+
 	if sc.RRule.Freq == rrule.WEEKLY && (sc.RRule.OrigOptions.Byweekday == nil || len(sc.RRule.OrigOptions.Byweekday) == 0) {
 		return errors.New("at least one day must be selected for weekly standup")
 	}
 
+	if sc.RRule.Freq == rrule.YEARLY && len(sc.RRule.Bymonth) != 1 {
+		return errors.New("exactly one month must be selected for yearly standup")
+	}
+
+	if sc.RRule.Freq == rrule.YEARLY && len(sc.RRule.Bymonthday) == 0 && len(sc.RRule.Bysetpos) == 0 {
+		return errors.New("either a day of month or a week of month must be selected for yearly standup")
+	}
+
+	if err := sc.validateScheduleExceptions(); err != nil {
+		return err
+	}
+
+	if err := sc.validateReminders(); err != nil {
+		return err
+	}
+
+	if err := sc.validateReminderMessages(); err != nil {
+		return err
+	}
+
+	if err := sc.validateReportTemplate(); err != nil {
+		return err
+	}
+
+	if err := sc.validateReportDestinations(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -204,7 +310,7 @@ func (sc *Config) initializeRRule() error {
 // So here we bring all timesets to current date (no alterting of time) to
 // get the current timezone picked up.
 func (sc *Config) fixRRuleTimezone() {
-	today := time.Now()
+	today := otime.GetClock().Now()
 	for i := range sc.RRule.Timeset {
 		sc.RRule.Timeset[i] = sc.RRule.Timeset[i].AddDate(today.Year()-1, int(today.Month())-1, today.Day()-1)
 	}
@@ -220,13 +326,35 @@ func (sc *Config) GenerateScheduleString() string {
 	var frequencyString string
 
 	switch sc.RRule.Freq {
+	case rrule.DAILY:
+		frequencyString = sc.generateDailySchedule()
 	case rrule.WEEKLY:
 		frequencyString = sc.generateWeeklySchedule()
 	case rrule.MONTHLY:
 		frequencyString = sc.generateMonthlySchedule()
+	case rrule.YEARLY:
+		frequencyString = sc.generateYearlySchedule()
+	}
+
+	title := "Standup Schedule"
+	if sc.StandupID != DefaultStandupID {
+		title += " (" + sc.StandupID + ")"
+	}
+
+	schedule := fmt.Sprintf("**%s**: %s %s to %s", title, frequencyString, windowOpenTime, windowCloseTime)
+	if sc.MemberTimezonesEnabled {
+		schedule += " in each member's local time"
 	}
 
-	return fmt.Sprintf("**Standup Schedule**: %s %s to %s", frequencyString, windowOpenTime, windowCloseTime)
+	return schedule
+}
+
+func (sc *Config) generateDailySchedule() string {
+	if sc.RRule.Interval == 1 {
+		return "Daily"
+	}
+
+	return fmt.Sprintf("Every %d days", sc.RRule.Interval)
 }
 
 func (sc *Config) generateWeeklySchedule() string {
@@ -247,7 +375,7 @@ func (sc *Config) generateWeeklySchedule() string {
 }
 
 func (sc *Config) generateMonthlySchedule() string {
-	var prefix, suffix string
+	var prefix string
 
 	if sc.RRule.Interval == 1 {
 		prefix = "Monthly"
@@ -255,50 +383,177 @@ func (sc *Config) generateMonthlySchedule() string {
 		prefix = fmt.Sprintf("Every %d months", sc.RRule.Interval)
 	}
 
+	return fmt.Sprintf("%s on the %s", prefix, sc.generateDayOfMonthSchedule())
+}
+
+func (sc *Config) generateYearlySchedule() string {
+	var prefix, month string
+
+	if sc.RRule.Interval == 1 {
+		prefix = "Yearly"
+	} else {
+		prefix = fmt.Sprintf("Every %d years", sc.RRule.Interval)
+	}
+
+	if len(sc.RRule.Bymonth) > 0 {
+		month = time.Month(sc.RRule.Bymonth[0]).String()
+	}
+
+	return fmt.Sprintf("%s on the %s of %s", prefix, sc.generateDayOfMonthSchedule(), month)
+}
+
+// generateDayOfMonthSchedule generates the day of month part of monthly and yearly schedules,
+// such as "1st" or "last weekday".
+func (sc *Config) generateDayOfMonthSchedule() string {
 	// this indicates "on date" mode,
 	// i.e. event occurs on specific day of month
 	if len(sc.RRule.Bymonthday) > 0 {
-		suffix = humanize.Ordinal(sc.RRule.Bymonthday[0])
-	} else if len(sc.RRule.Bysetpos) > 0 {
-		weekOrdinal := weekRanks[sc.RRule.Bysetpos[0]]
-
-		var dayOfWeek string
-		switch len(sc.RRule.Byweekday) {
-		case 1:
-			// single day
-			dayOfWeek = time.Weekday(sc.RRule.Byweekday[0] + 1).String()
-		case 2:
-			// weekend
-			dayOfWeek = "weekend"
-		case 5:
-			// weekday
-			dayOfWeek = "weekday"
-		case 7:
-			// any day
-			dayOfWeek = "day"
-		}
+		return humanize.Ordinal(sc.RRule.Bymonthday[0])
+	}
 
-		suffix = weekOrdinal + " " + dayOfWeek
+	if len(sc.RRule.Bysetpos) == 0 {
+		return ""
 	}
 
-	return fmt.Sprintf("%s on the %s", prefix, suffix)
+	weekOrdinal := weekRanks[sc.RRule.Bysetpos[0]]
+
+	var dayOfWeek string
+	switch len(sc.RRule.Byweekday) {
+	case 1:
+		// single day
+		dayOfWeek = time.Weekday((sc.RRule.Byweekday[0] + 1) % 7).String()
+	case 2:
+		// weekend
+		dayOfWeek = "weekend"
+	case 5:
+		// weekday
+		dayOfWeek = "weekday"
+	case 7:
+		// any day
+		dayOfWeek = "day"
+	}
+
+	return weekOrdinal + " " + dayOfWeek
 }
 
-// AddStandupChannel adds the specified channel to the list of standup channels.
+// ValidateStandupID checks that the standup ID is short and
+// made up of only lowercase letters, digits, hyphens and underscores.
+// DefaultStandupID is always valid.
+func ValidateStandupID(standupID string) error {
+	if standupID == DefaultStandupID {
+		return nil
+	}
+
+	if len(standupID) > standupIDMaxLength {
+		return fmt.Errorf("standup ID cannot be longer than %d characters", standupIDMaxLength)
+	}
+
+	if !standupIDRegex.MatchString(standupID) {
+		return errors.New("standup ID can only contain lowercase letters, digits, hyphens and underscores, and must start with a letter or digit")
+	}
+
+	return nil
+}
+
+// StandupKey generates the key of a standup in the list of standup channels.
+// It's the channel ID itself for the default standup, keeping the list
+// same as it was before channels could have multiple standups.
+func StandupKey(channelID, standupID string) string {
+	return withStandupID(channelID, standupID)
+}
+
+// ParseStandupKey extracts the standup ID from a key generated by StandupKey.
+func ParseStandupKey(key, channelID string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(key, channelID), "_")
+}
+
+// AddStandupChannel adds the specified standup to the list of standup channels.
 // This is later user for iterating over all standup channels.
-func AddStandupChannel(channelID string) error {
-	logger.Debug(fmt.Sprintf("Adding standup channel: %s", channelID), nil)
+func AddStandupChannel(channelID, standupID string) error {
+	logger.Debug(fmt.Sprintf("Adding standup channel: %s, standup: %s", channelID, standupID), nil)
 
 	channels, err := GetStandupChannels()
 	if err != nil {
 		return err
 	}
 
-	channels[channelID] = channelID
+	channels[StandupKey(channelID, standupID)] = channelID
 	return setStandupChannels(channels)
 }
 
-// RemoveStandupChannels removes all specified channels from list of standup channels.
+// GetChannelStandupConfigs fetches configs of all standups of the channel,
+// the default standup first followed by others ordered by their ID.
+func GetChannelStandupConfigs(channelID string) ([]*Config, error) {
+	channels, err := GetStandupChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	standupIDs := []string{}
+	for key, standupChannelID := range channels {
+		if standupChannelID == channelID {
+			standupIDs = append(standupIDs, ParseStandupKey(key, channelID))
+		}
+	}
+	sort.Strings(standupIDs)
+
+	standupConfigs := []*Config{}
+	for _, standupID := range standupIDs {
+		standupConfig, err := GetStandupConfig(channelID, standupID)
+		if err != nil {
+			return nil, err
+		}
+
+		if standupConfig != nil {
+			standupConfigs = append(standupConfigs, standupConfig)
+		}
+	}
+
+	return standupConfigs, nil
+}
+
+// HasEnabledStandup checks if any of the channel's standups is enabled.
+func HasEnabledStandup(channelID string) (bool, error) {
+	standupConfigs, err := GetChannelStandupConfigs(channelID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, standupConfig := range standupConfigs {
+		if standupConfig.Enabled {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// PublishActiveChannelEvent notifies the user whether the channel
+// still has an active standup after one of its standups was updated.
+func PublishActiveChannelEvent(channelID, userID string) {
+	active, err := HasEnabledStandup(channelID)
+	if err != nil {
+		logger.Error("Couldn't check for active standups of channel", err, map[string]interface{}{"channelID": channelID})
+		return
+	}
+
+	event := "remove_active_channel"
+	if active {
+		event = "add_active_channel"
+	}
+
+	config.Mattermost.PublishWebSocketEvent(
+		event,
+		map[string]interface{}{
+			"channel_id": channelID,
+		},
+		&model.WebsocketBroadcast{
+			UserId: userID,
+		},
+	)
+}
+
+// RemoveStandupChannels removes default standup of all specified channels from list of standup channels.
 // This is later user for iterating over all standup channels.
 func RemoveStandupChannels(channelIDs []string) error {
 	logger.Debug(fmt.Sprintf("Removing standup channels: %v", channelIDs), nil)
@@ -317,45 +572,76 @@ func RemoveStandupChannels(channelIDs []string) error {
 
 // ArchiveStandupChannels archives the channel standup config.
 func ArchiveStandupChannels(channelID string) error {
-	key := util.GetKeyHash(config.CacheKeyPrefixTeamStandupConfig + channelID)
-	data, appErr := config.Mattermost.KVGet(key)
-	if appErr != nil {
-		logger.Error("Couldn't fetch standup config for channel from KV store", appErr, map[string]interface{}{"channelID": channelID})
-		return errors.New(appErr.Error())
+	return store.ArchiveStandupConfig(channelID)
+}
+
+// GetArchivedStandupConfigs fetches standup configs of all archived channels.
+func GetArchivedStandupConfigs() ([]*Config, error) {
+	logger.Debug("Fetching all archived standup configs", nil)
+	return store.GetArchivedStandupConfigs()
+}
+
+// RestoreArchivedStandupConfig validates and restores the archived standup config of the channel
+// and adds the channel back to the list of standup channels.
+func RestoreArchivedStandupConfig(channelID, userID string) (*Config, error) {
+	logger.Debug(fmt.Sprintf("Restoring archived standup config for channel: %s", channelID), nil)
+
+	archivedConfig, err := store.GetArchivedStandupConfig(channelID)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := config.Mattermost.KVSet(key+"_DEL", data); err != nil {
-		logger.Error("Failed to save archived copy of channel configuration.", err, map[string]interface{}{"channel_id": channelID})
-		return err
+	if archivedConfig == nil {
+		return nil, errors.New("no archived standup config found for channel: " + channelID)
 	}
 
-	if err := config.Mattermost.KVDelete(key); err != nil {
-		logger.Error("Failed to delete standup config after saving archived copy.", err, map[string]interface{}{"channel_id": channelID})
-		return err
+	existingConfig, err := GetStandupConfig(channelID, DefaultStandupID)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	if existingConfig != nil {
+		return nil, errors.New("standup is already configured for channel: " + channelID)
+	}
+
+	if archivedConfig.ChannelID != channelID || archivedConfig.StandupID != DefaultStandupID {
+		return nil, errors.New("archived standup config doesn't belong to channel: " + channelID)
+	}
+
+	if err := archivedConfig.PreSave(); err != nil {
+		return nil, err
+	}
+
+	if err := archivedConfig.IsValid(); err != nil {
+		return nil, err
+	}
+
+	restoredConfig, err := SaveStandupConfig(archivedConfig, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := AddStandupChannel(channelID, DefaultStandupID); err != nil {
+		return nil, err
+	}
+
+	if err := store.DeleteArchivedStandupConfig(channelID); err != nil {
+		// log and continue. Leftover archive doesn't affect the restored standup
+		logger.Error("Couldn't delete archived standup config after restoring it", err, map[string]interface{}{"channelID": channelID})
+	}
+
+	return restoredConfig, nil
 }
 
 // GetStandupChannels fetches all channels where standup is configured.
-// Returns a map of channel ID to channel ID for maintaining uniqueness.
+// Returns a map of standup key, as generated by StandupKey, to channel ID
+// with an entry for every standup of every channel.
 func GetStandupChannels() (map[string]string, error) {
 	logger.Debug("Fetching all standup channels", nil)
 
-	data, appErr := config.Mattermost.KVGet(util.GetKeyHash(config.CacheKeyAllStandupChannels))
-	if appErr != nil {
-		logger.Error("Couldn't fetch standup channel list from KV store", appErr, nil)
-		return nil, errors.New(appErr.Error())
-	}
-
-	channels := map[string]string{}
-
-	if len(data) > 0 {
-		err := json.Unmarshal(data, &channels)
-		if err != nil {
-			logger.Error("Couldn't unmarshal standup channel list into map", err, map[string]interface{}{"data": string(data)})
-			return nil, err
-		}
+	channels, err := store.GetStandupChannels()
+	if err != nil {
+		return nil, err
 	}
 
 	logger.Debug(fmt.Sprintf("Found %d standup channels", len(channels)), nil)
@@ -365,77 +651,111 @@ func GetStandupChannels() (map[string]string, error) {
 // SaveUserStandup saves a user's standup for a channel
 func SaveUserStandup(userStandup *UserStandup) error {
 	// span across time zones.
-	standupConfig, err := GetStandupConfig(userStandup.ChannelID)
+	standupConfig, err := GetStandupConfig(userStandup.ChannelID, userStandup.StandupID)
 	if err != nil {
 		return err
 	}
 	if standupConfig == nil {
 		return errors.New("standup not configured for channel: " + userStandup.ChannelID)
 	}
-	key := otime.Now(standupConfig.Timezone).GetDateString() + "_" + userStandup.ChannelID + userStandup.UserID
-	bytes, err := json.Marshal(userStandup)
+
+	date, err := GetMemberStandupDate(standupConfig, userStandup.UserID)
 	if err != nil {
-		logger.Error("Error occurred in serializing user standup", err, nil)
 		return err
 	}
 
-	if appErr := config.Mattermost.KVSet(util.GetKeyHash(key), bytes); appErr != nil {
-		logger.Error("Error occurred in saving user standup in KV store", errors.New(appErr.Error()), nil)
-		return appErr
+	return SaveUserStandupForDate(userStandup, date)
+}
+
+// SaveUserStandupForDate saves a user's standup for a channel against the specified date.
+func SaveUserStandupForDate(userStandup *UserStandup, date otime.OTime) error {
+	return store.SaveUserStandup(date.GetDateString(), userStandup)
+}
+
+// GetUserStandup fetches a user's standup for the specified channel standup and date.
+func GetUserStandup(userID, channelID, standupID string, date otime.OTime) (*UserStandup, error) {
+	return store.GetUserStandup(userID, channelID, standupID, date.GetDateString())
+}
+
+// ValidateStandupHistoryRange checks that the date range is
+// in order and not larger than StandupHistoryMaxDays.
+func ValidateStandupHistoryRange(from, to otime.OTime) error {
+	if from.After(to.Time) {
+		return errors.New("start date cannot be after end date")
+	}
+
+	if to.After(from.AddDate(0, 0, StandupHistoryMaxDays-1)) {
+		return fmt.Errorf("date range too large. At most %d days of standup history can be fetched at once", StandupHistoryMaxDays)
 	}
 
 	return nil
 }
 
-// GetUserStandup fetches a user's standup for the specified channel and date.
-func GetUserStandup(userID, channelID string, date otime.OTime) (*UserStandup, error) {
-	key := date.GetDateString() + "_" + channelID + userID
-	data, appErr := config.Mattermost.KVGet(util.GetKeyHash(key))
-	if appErr != nil {
-		logger.Error("Couldn't fetch user standup from KV store", appErr, map[string]interface{}{"userID": userID, "channelID": channelID})
-		return nil, errors.New(appErr.Error())
+// GetUserStandupHistory fetches all standups submitted by a user in the specified channel
+// between the two dates, both inclusive. Standups are ordered by date, oldest first.
+func GetUserStandupHistory(userID, channelID, standupID string, from, to otime.OTime) ([]*DatedUserStandup, error) {
+	if err := ValidateStandupHistoryRange(from, to); err != nil {
+		return nil, err
 	}
 
-	if len(data) == 0 {
-		return nil, nil
+	history := []*DatedUserStandup{}
+	for date := from; !date.After(to.Time); date = (otime.OTime{Time: date.AddDate(0, 0, 1)}) {
+		userStandup, err := GetUserStandup(userID, channelID, standupID, date)
+		if err != nil {
+			return nil, err
+		}
+
+		if userStandup == nil {
+			continue
+		}
+
+		history = append(history, &DatedUserStandup{
+			Date:        date.Format(otime.LayoutISODate),
+			UserStandup: userStandup,
+		})
 	}
 
-	userStandup := &UserStandup{}
-	if err := json.Unmarshal(data, userStandup); err != nil {
-		logger.Error("Couldn't unmarshal user standup data", err, nil)
-		return nil, err
+	return history, nil
+}
+
+// IsStandupMember checks if the user is a current member of the standup
+// or a previous member recorded in its config history.
+func IsStandupMember(standupConfig *Config, userID string) (bool, error) {
+	history, err := store.GetStandupConfigHistory(standupConfig.ChannelID, standupConfig.StandupID)
+	if err != nil {
+		return false, err
 	}
 
-	return userStandup, nil
+	return funk.ContainsString(getAllStandupMembers(standupConfig, history), userID), nil
 }
 
 // TODO this should return the set config
-// SaveStandupConfig saves standup config for the specified channel
-func SaveStandupConfig(standupConfig *Config) (*Config, error) {
+// SaveStandupConfig saves standup config for the specified channel.
+// The saved config is also recorded in the channel's config history
+// as a new version authored by the specified user.
+func SaveStandupConfig(standupConfig *Config, userID string) (*Config, error) {
 	logger.Debug(fmt.Sprintf("Saving standup config for channel: %s", standupConfig.ChannelID), nil)
 
 	standupConfig.Members = funk.UniqString(standupConfig.Members)
-	serializedStandupConfig, err := json.Marshal(standupConfig)
-	if err != nil {
-		logger.Error("Couldn't marshal standup config", err, nil)
-		return nil, err
-	}
 
 	if err := updateChannelHeader(standupConfig); err != nil {
 		return nil, err
 	}
 
-	key := config.CacheKeyPrefixTeamStandupConfig + standupConfig.ChannelID
-	if err := config.Mattermost.KVSet(util.GetKeyHash(key), serializedStandupConfig); err != nil {
-		logger.Error("Couldn't save channel standup config in KV store", err, map[string]interface{}{"channelID": standupConfig.ChannelID})
+	if err := store.SaveStandupConfig(standupConfig); err != nil {
 		return nil, err
 	}
 
+	if err := addStandupConfigVersion(standupConfig, userID); err != nil {
+		// log and continue. Config history shouldn't affect primary flow
+		logger.Error("Couldn't record standup config version", err, map[string]interface{}{"channelID": standupConfig.ChannelID})
+	}
+
 	return standupConfig, nil
 }
 
 func updateChannelHeader(newConfig *Config) error {
-	oldConfig, err := GetStandupConfig(newConfig.ChannelID)
+	oldConfig, err := GetStandupConfig(newConfig.ChannelID, newConfig.StandupID)
 	if err != nil {
 		return err
 	}
@@ -460,11 +780,11 @@ func updateChannelHeader(newConfig *Config) error {
 
 	switch {
 	case oldConfig.ScheduleEnabled && !newConfig.ScheduleEnabled:
-		channel.Header = removeChannelHeaderSchedule(channel.Header)
+		channel.Header = removeChannelHeaderSchedule(channel.Header, newConfig.StandupID)
 	case !oldConfig.ScheduleEnabled && newConfig.ScheduleEnabled:
 		channel.Header = addChannelHeaderSchedule(channel.Header, newConfig.GenerateScheduleString())
 	case oldConfig.ScheduleEnabled && newConfig.ScheduleEnabled:
-		channel.Header = removeChannelHeaderSchedule(channel.Header)
+		channel.Header = removeChannelHeaderSchedule(channel.Header, newConfig.StandupID)
 		channel.Header = addChannelHeaderSchedule(channel.Header, newConfig.GenerateScheduleString())
 	}
 
@@ -476,17 +796,23 @@ func updateChannelHeader(newConfig *Config) error {
 	return nil
 }
 
-func removeChannelHeaderSchedule(channelHeader string) string {
-	var userDefinedHeader string
-
+// removeChannelHeaderSchedule removes schedule of the specified standup from channel header.
+// Schedules of all channel standups are placed at the beginning of the header,
+// followed by the user defined header.
+func removeChannelHeaderSchedule(channelHeader, standupID string) string {
 	components := strings.Split(channelHeader, channelHeaderScheduleSeparator)
-	if standupScheduleRegex.MatchString(strings.TrimSpace(components[0])) {
-		userDefinedHeader = strings.Join(components[1:], channelHeaderScheduleSeparator)
-	} else {
-		userDefinedHeader = channelHeader
+	for i, component := range components {
+		match := standupScheduleRegex.FindStringSubmatch(strings.TrimSpace(component))
+		if match == nil {
+			break
+		}
+
+		if match[1] == standupID {
+			return strings.TrimSpace(strings.Join(append(components[:i:i], components[i+1:]...), channelHeaderScheduleSeparator))
+		}
 	}
 
-	return userDefinedHeader
+	return channelHeader
 }
 
 func addChannelHeaderSchedule(channelHeader, schedule string) string {
@@ -497,29 +823,17 @@ func addChannelHeaderSchedule(channelHeader, schedule string) string {
 	return schedule + standupScheduleEndMarker + " | " + channelHeader
 }
 
-// GetStandupConfig fetches standup config for the specified channel
-func GetStandupConfig(channelID string) (*Config, error) {
-	logger.Debug(fmt.Sprintf("Fetching standup config for channel: %s", channelID), nil)
+// GetStandupConfig fetches config of the specified standup of the channel
+func GetStandupConfig(channelID, standupID string) (*Config, error) {
+	logger.Debug(fmt.Sprintf("Fetching standup config for channel: %s, standup: %s", channelID, standupID), nil)
 
-	key := config.CacheKeyPrefixTeamStandupConfig + channelID
-	data, appErr := config.Mattermost.KVGet(util.GetKeyHash(key))
-	if appErr != nil {
-		logger.Error("Couldn't fetch standup config for channel from KV store", appErr, map[string]interface{}{"channelID": channelID})
-		return nil, errors.New(appErr.Error())
+	standupConfig, err := store.GetStandupConfig(channelID, standupID)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(data) == 0 {
+	if standupConfig == nil {
 		logger.Debug(fmt.Sprintf("Counldn't find standup config for channel: %s", channelID), nil)
-		return nil, nil
-	}
-
-	var standupConfig *Config
-	if len(data) > 0 {
-		standupConfig = &Config{}
-		if err := json.Unmarshal(data, standupConfig); err != nil {
-			logger.Error("Couldn't unmarshal data into standup config", err, nil)
-			return nil, err
-		}
 	}
 
 	return standupConfig, nil
@@ -528,16 +842,5 @@ func GetStandupConfig(channelID string) (*Config, error) {
 // setStandupChannels saves the provided list of standup channels in the KV store
 func setStandupChannels(channels map[string]string) error {
 	logger.Debug("Saving standup channels", nil)
-
-	data, err := json.Marshal(channels)
-	if err != nil {
-		return err
-	}
-
-	appErr := config.Mattermost.KVSet(util.GetKeyHash(config.CacheKeyAllStandupChannels), data)
-	if appErr != nil {
-		return errors.New(appErr.Error())
-	}
-
-	return nil
+	return store.SetStandupChannels(channels)
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -36,7 +37,6 @@ func baseMock() *plugintest.API {
 	}
 
 	config.SetConfig(mockConfig)
-	otime.DefaultLocation = location
 
 	return mockAPI
 }
@@ -101,8 +101,6 @@ func TestStandupConfig_IsValid(t *testing.T) {
 		Location: location,
 	}
 
-	otime.DefaultLocation = location
-
 	config.SetConfig(mockConfig)
 
 	windowOpenTime, _ := otime.Parse("13:05")
@@ -144,7 +142,10 @@ func TestStandupConfig_IsValid(t *testing.T) {
 	standupConfig.ChannelID = "channel_id"
 	standupConfig.WindowOpenTime, _ = otime.Parse("10:00")
 	standupConfig.WindowCloseTime, _ = otime.Parse("09:00")
-	assert.NotNil(t, standupConfig.IsValid(), "should be invalid as window open time is after window close time")
+	assert.Nil(t, standupConfig.IsValid(), "should be valid as window can cross midnight")
+
+	standupConfig.WindowCloseTime, _ = otime.Parse("10:00")
+	assert.NotNil(t, standupConfig.IsValid(), "should be invalid as window open time is same as window close time")
 
 	standupConfig.WindowOpenTime = windowOpenTime
 	standupConfig.WindowCloseTime = windowCloseTime
@@ -177,6 +178,26 @@ func TestStandupConfig_IsValid(t *testing.T) {
 	assert.NotNil(t, standupConfig.IsValid(), "should not be valid as no days are specified with weekly standup")
 	standupConfig.RRule = rule
 
+	for _, rruleString := range []string{
+		"FREQ=DAILY;INTERVAL=2",
+		"FREQ=YEARLY;BYMONTH=3;BYMONTHDAY=15",
+		"FREQ=YEARLY;BYSETPOS=1;BYDAY=MO;BYMONTH=1",
+	} {
+		standupConfig.RRule, _ = util.ParseRRuleFromString(rruleString, time.Now().Add(-5*24*time.Hour))
+		assert.Nil(t, standupConfig.IsValid(), "should be valid as %s is a supported schedule", rruleString)
+	}
+
+	for _, rruleString := range []string{
+		"FREQ=HOURLY;INTERVAL=1",
+		"FREQ=YEARLY;BYMONTH=3,4;BYMONTHDAY=15",
+		"FREQ=YEARLY;BYMONTHDAY=15",
+		"FREQ=YEARLY;BYMONTH=3;BYDAY=MO",
+	} {
+		standupConfig.RRule, _ = util.ParseRRuleFromString(rruleString, time.Now().Add(-5*24*time.Hour))
+		assert.NotNil(t, standupConfig.IsValid(), "should not be valid as %s is not a supported schedule", rruleString)
+	}
+	standupConfig.RRule = rule
+
 	// testing invalid timezone
 	standupConfig.Timezone = "Invalid-timezone"
 	assert.NotNil(t, standupConfig.IsValid(), "should not be valid as specified timezone is invalid")
@@ -288,17 +309,17 @@ func TestAddStandupChannel(t *testing.T) {
 	})
 	defer monkey.Unpatch(setStandupChannels)
 
-	assert.Nil(t, AddStandupChannel("channel_2"), "should not produce error")
+	assert.Nil(t, AddStandupChannel("channel_2", ""), "should not produce error")
 
 	monkey.Patch(setStandupChannels, func(channels map[string]string) error {
 		return errors.New("")
 	})
-	assert.NotNil(t, AddStandupChannel("channel_2"), "should produce error as couldn't save standup channels")
+	assert.NotNil(t, AddStandupChannel("channel_2", ""), "should produce error as couldn't save standup channels")
 
 	monkey.Patch(GetStandupChannels, func() (map[string]string, error) {
 		return nil, errors.New("")
 	})
-	assert.NotNil(t, AddStandupChannel("channel_2"), "should produce error as couldn't fetch existing standup channels")
+	assert.NotNil(t, AddStandupChannel("channel_2", ""), "should produce error as couldn't fetch existing standup channels")
 
 	monkey.Patch(GetStandupChannels, func() (map[string]string, error) {
 		return map[string]string{
@@ -309,7 +330,7 @@ func TestAddStandupChannel(t *testing.T) {
 	monkey.Patch(setStandupChannels, func(channels map[string]string) error {
 		return nil
 	})
-	assert.Nil(t, AddStandupChannel("channel_1"), "shouldn't fail if adding a channel which was already a standup channel")
+	assert.Nil(t, AddStandupChannel("channel_1", ""), "shouldn't fail if adding a channel which was already a standup channel")
 }
 
 func TestAddStandupChannel_IntegrationTest(t *testing.T) {
@@ -321,7 +342,7 @@ func TestAddStandupChannel_IntegrationTest(t *testing.T) {
 	mockAPI.On("KVGet", "uScyewRiWEwQavauYw9iOK76jISl+5Qq0mV+Cn/jFPs=").Return([]byte("{\"channel_1\":\"channel_1\"}"), nil)
 	mockAPI.On("KVSet", "uScyewRiWEwQavauYw9iOK76jISl+5Qq0mV+Cn/jFPs=", mock.Anything).Return(nil)
 
-	assert.Nil(t, AddStandupChannel("channel_2"), "should not produce error")
+	assert.Nil(t, AddStandupChannel("channel_2", ""), "should not produce error")
 
 	mockAPI = &plugintest.API{}
 	config.Mattermost = mockAPI
@@ -329,7 +350,7 @@ func TestAddStandupChannel_IntegrationTest(t *testing.T) {
 	mockAPI.On("LogDebug", mock.AnythingOfType("string"))
 	mockAPI.On("KVGet", "uScyewRiWEwQavauYw9iOK76jISl+5Qq0mV+Cn/jFPs=").Return([]byte("{\"channel_1\":\"channel_1\"}"), nil)
 	mockAPI.On("KVSet", "uScyewRiWEwQavauYw9iOK76jISl+5Qq0mV+Cn/jFPs=", mock.Anything).Return(model.NewAppError("", "", nil, "", 0))
-	assert.NotNil(t, AddStandupChannel("channel_2"), "should produce error as couldn't save standup channels")
+	assert.NotNil(t, AddStandupChannel("channel_2", ""), "should produce error as couldn't save standup channels")
 
 	mockAPI = &plugintest.API{}
 	config.Mattermost = mockAPI
@@ -337,7 +358,7 @@ func TestAddStandupChannel_IntegrationTest(t *testing.T) {
 	mockAPI.On("LogDebug", mock.AnythingOfType("string"))
 	mockAPI.On("LogError", mock.AnythingOfType("string"), mock.AnythingOfType("string"))
 	mockAPI.On("KVGet", "uScyewRiWEwQavauYw9iOK76jISl+5Qq0mV+Cn/jFPs=").Return(nil, model.NewAppError("", "", nil, "", 0))
-	assert.NotNil(t, AddStandupChannel("channel_2"), "should produce error as couldn't fetch existing standup channels")
+	assert.NotNil(t, AddStandupChannel("channel_2", ""), "should produce error as couldn't fetch existing standup channels")
 
 	mockAPI = &plugintest.API{}
 	config.Mattermost = mockAPI
@@ -345,7 +366,7 @@ func TestAddStandupChannel_IntegrationTest(t *testing.T) {
 	mockAPI.On("LogDebug", mock.AnythingOfType("string"))
 	mockAPI.On("KVGet", "uScyewRiWEwQavauYw9iOK76jISl+5Qq0mV+Cn/jFPs=").Return([]byte("{\"channel_1\":\"channel_1\"}"), nil)
 	mockAPI.On("KVSet", "uScyewRiWEwQavauYw9iOK76jISl+5Qq0mV+Cn/jFPs=", mock.Anything).Return(nil)
-	assert.Nil(t, AddStandupChannel("channel_1"), "should fail if adding a channel which was already a standup channel")
+	assert.Nil(t, AddStandupChannel("channel_1", ""), "should fail if adding a channel which was already a standup channel")
 }
 
 func TestGetStandupChannels(t *testing.T) {
@@ -393,7 +414,7 @@ func TestSaveUserStandup(t *testing.T) {
 	mockAPI := baseMock()
 	mockAPI.On("KVSet", mock.AnythingOfType("string"), mock.Anything).Return(nil)
 
-	monkey.Patch(GetStandupConfig, func(channelID string) (*Config, error) {
+	monkey.Patch(GetStandupConfig, func(channelID, standupID string) (*Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-55 * time.Minute),
 		}
@@ -448,7 +469,7 @@ func TestGetUserStandup(t *testing.T) {
 	})
 	mockAPI.On("KVGet", mock.AnythingOfType("string")).Return(userStandupBytes, nil)
 
-	userStandup, err := GetUserStandup("user_id", "channel_id", otime.Now("Asia/Kolkata"))
+	userStandup, err := GetUserStandup("user_id", "channel_id", "", otime.Now("Asia/Kolkata"))
 	assert.Nil(t, err, "no error should have been produced")
 	assert.Equal(t, &UserStandup{
 		UserID:    "user_id",
@@ -464,13 +485,13 @@ func TestGetUserStandup(t *testing.T) {
 	mockAPI = baseMock()
 	mockAPI.On("KVGet", mock.AnythingOfType("string")).Return(nil, util.EmptyAppError())
 
-	userStandup, err = GetUserStandup("user_id", "channel_id", otime.Now("Asia/Kolkata"))
+	userStandup, err = GetUserStandup("user_id", "channel_id", "", otime.Now("Asia/Kolkata"))
 	assert.NotNil(t, err, "error should have been produced as KVGet failed")
 	assert.Nil(t, userStandup)
 
 	mockAPI = baseMock()
 	mockAPI.On("KVGet", mock.AnythingOfType("string")).Return([]byte{}, nil)
-	userStandup, err = GetUserStandup("user_id", "channel_id", otime.Now("Asia/Kolkata"))
+	userStandup, err = GetUserStandup("user_id", "channel_id", "", otime.Now("Asia/Kolkata"))
 	assert.Nil(t, err, "no error should have been produced")
 	assert.Nil(t, userStandup, "no user standup should have been found")
 }
@@ -479,6 +500,7 @@ func TestSaveStandupConfig(t *testing.T) {
 	defer TearDown()
 	mockAPI := baseMock()
 	mockAPI.On("KVGet", util.GetKeyHash("standup_config_channel_id")).Return([]byte("{\"scheduleEnabled\":false}"), nil)
+	mockAPI.On("KVGet", util.GetKeyHash("standup_config_history_channel_id")).Return(nil, nil)
 	mockAPI.On("KVSet", mock.AnythingOfType("string"), mock.Anything).Return(nil)
 	mockAPI.On("GetChannel", "channel_id").Return(&model.Channel{}, nil)
 	mockAPI.On("UpdateChannel", mock.Anything).Return(nil, nil)
@@ -503,17 +525,18 @@ func TestSaveStandupConfig(t *testing.T) {
 
 	standupConfig.RRule = rule
 
-	savedStandupConfig, err := SaveStandupConfig(standupConfig)
+	savedStandupConfig, err := SaveStandupConfig(standupConfig, "user_id")
 	assert.Nil(t, err, "no error should have been produced")
 	assert.Equal(t, standupConfig, savedStandupConfig, "both standup config should be identical")
 
 	mockAPI = baseMock()
 	mockAPI.On("KVGet", util.GetKeyHash("standup_config_channel_id")).Return([]byte("{\"scheduleEnabled\":false}"), nil)
+	mockAPI.On("KVGet", util.GetKeyHash("standup_config_history_channel_id")).Return(nil, nil)
 	mockAPI.On("GetChannel", "channel_id").Return(&model.Channel{}, nil)
 	mockAPI.On("UpdateChannel", mock.Anything).Return(nil, nil)
 	mockAPI.On("KVSet", mock.AnythingOfType("string"), mock.Anything).Return(util.EmptyAppError())
 
-	savedStandupConfig, err = SaveStandupConfig(standupConfig)
+	savedStandupConfig, err = SaveStandupConfig(standupConfig, "user_id")
 	assert.Error(t, err, "error should have been produced as KVSet failed")
 	assert.Nil(t, savedStandupConfig, "no standup config should have been returned")
 }
@@ -522,6 +545,7 @@ func TestSaveStandupConfig_DuplicateMembers(t *testing.T) {
 	defer TearDown()
 	mockAPI := baseMock()
 	mockAPI.On("KVGet", util.GetKeyHash("standup_config_channel_id")).Return([]byte("{\"scheduleEnabled\":false}"), nil)
+	mockAPI.On("KVGet", util.GetKeyHash("standup_config_history_channel_id")).Return(nil, nil)
 	mockAPI.On("GetChannel", "channel_id").Return(&model.Channel{}, nil)
 	mockAPI.On("UpdateChannel", mock.Anything).Return(nil, nil)
 	mockAPI.On("KVSet", mock.AnythingOfType("string"), mock.Anything).Return(nil)
@@ -538,7 +562,7 @@ func TestSaveStandupConfig_DuplicateMembers(t *testing.T) {
 		Enabled:         true,
 	}
 
-	savedStandupConfig, err := SaveStandupConfig(standupConfig)
+	savedStandupConfig, err := SaveStandupConfig(standupConfig, "user_id")
 	assert.Nil(t, err, "no error should have been produced")
 	assert.Equal(t, &Config{
 		ChannelID:       "channel_id",
@@ -566,7 +590,7 @@ func TestGetStandupConfig(t *testing.T) {
 	})
 	mockAPI.On("KVGet", mock.AnythingOfType("string")).Return(standupConfigBytes, nil)
 
-	standupConfig, err := GetStandupConfig("channel_id")
+	standupConfig, err := GetStandupConfig("channel_id", "")
 	assert.Nil(t, err, "no error should have been produced")
 	assert.Equal(t, "channel_id", standupConfig.ChannelID, "both standup configs should be identical")
 	assert.Equal(t, []string{"user_id_1"}, standupConfig.Members, "both standup configs should be identical")
@@ -576,13 +600,13 @@ func TestGetStandupConfig(t *testing.T) {
 
 	mockAPI = baseMock()
 	mockAPI.On("KVGet", mock.AnythingOfType("string")).Return(nil, util.EmptyAppError())
-	standupConfig, err = GetStandupConfig("channel_id")
+	standupConfig, err = GetStandupConfig("channel_id", "")
 	assert.NotNil(t, err, "error should have been produced as KVGet failed")
 	assert.Nil(t, standupConfig, "no standup config should have been returned")
 
 	mockAPI = baseMock()
 	mockAPI.On("KVGet", mock.AnythingOfType("string")).Return([]byte{}, nil)
-	standupConfig, err = GetStandupConfig("channel_id")
+	standupConfig, err = GetStandupConfig("channel_id", "")
 	assert.Nil(t, err, "no error should have been produced as lack of standup config is valid scenerio")
 	assert.Nil(t, standupConfig, "no standup config should have been returned")
 }
@@ -591,8 +615,6 @@ func TestStandupConfig_GenerateScheduleString(t *testing.T) {
 	location, err := time.LoadLocation("Asia/Kolkata")
 	assert.Nil(t, err, "location should have loaded successfully")
 
-	otime.DefaultLocation = location
-
 	conf := &config.Configuration{
 		TimeZone:                "Asia/Kolkata",
 		PermissionSchemaEnabled: false,
@@ -865,6 +887,84 @@ func TestStandupConfig_GenerateScheduleString(t *testing.T) {
 	standupConfig.RRule = rule
 	standupScheduleString = standupConfig.GenerateScheduleString()
 	assert.Equal(t, "**Standup Schedule**: Monthly on the last weekend 10:00 to 15:00", standupScheduleString)
+
+	// every month on the second Sunday
+	rruleString = "FREQ=MONTHLY;INTERVAL=1;BYSETPOS=2;BYDAY=SU;COUNT=5"
+	rule, err = util.ParseRRuleFromString(rruleString, time.Now().Add(-5*24*time.Hour))
+	if err != nil {
+		t.Fatal("Couldn't parse RRULE", err)
+		return
+	}
+
+	standupConfig.RRuleString = rruleString
+	standupConfig.RRule = rule
+	standupScheduleString = standupConfig.GenerateScheduleString()
+	assert.Equal(t, "**Standup Schedule**: Monthly on the second Sunday 10:00 to 15:00", standupScheduleString)
+
+	// every day
+	rruleString = "FREQ=DAILY;INTERVAL=1"
+	rule, err = util.ParseRRuleFromString(rruleString, time.Now().Add(-5*24*time.Hour))
+	if err != nil {
+		t.Fatal("Couldn't parse RRULE", err)
+		return
+	}
+
+	standupConfig.RRuleString = rruleString
+	standupConfig.RRule = rule
+	standupScheduleString = standupConfig.GenerateScheduleString()
+	assert.Equal(t, "**Standup Schedule**: Daily 10:00 to 15:00", standupScheduleString)
+
+	// every 3 days
+	rruleString = "FREQ=DAILY;INTERVAL=3"
+	rule, err = util.ParseRRuleFromString(rruleString, time.Now().Add(-5*24*time.Hour))
+	if err != nil {
+		t.Fatal("Couldn't parse RRULE", err)
+		return
+	}
+
+	standupConfig.RRuleString = rruleString
+	standupConfig.RRule = rule
+	standupScheduleString = standupConfig.GenerateScheduleString()
+	assert.Equal(t, "**Standup Schedule**: Every 3 days 10:00 to 15:00", standupScheduleString)
+
+	// every year on 15th March
+	rruleString = "FREQ=YEARLY;BYMONTH=3;BYMONTHDAY=15"
+	rule, err = util.ParseRRuleFromString(rruleString, time.Now().Add(-5*24*time.Hour))
+	if err != nil {
+		t.Fatal("Couldn't parse RRULE", err)
+		return
+	}
+
+	standupConfig.RRuleString = rruleString
+	standupConfig.RRule = rule
+	standupScheduleString = standupConfig.GenerateScheduleString()
+	assert.Equal(t, "**Standup Schedule**: Yearly on the 15th of March 10:00 to 15:00", standupScheduleString)
+
+	// every 2 years on the last weekday of December
+	rruleString = "FREQ=YEARLY;INTERVAL=2;BYSETPOS=-1;BYDAY=MO,TU,WE,TH,FR;BYMONTH=12"
+	rule, err = util.ParseRRuleFromString(rruleString, time.Now().Add(-5*24*time.Hour))
+	if err != nil {
+		t.Fatal("Couldn't parse RRULE", err)
+		return
+	}
+
+	standupConfig.RRuleString = rruleString
+	standupConfig.RRule = rule
+	standupScheduleString = standupConfig.GenerateScheduleString()
+	assert.Equal(t, "**Standup Schedule**: Every 2 years on the last weekday of December 10:00 to 15:00", standupScheduleString)
+
+	// every year on standup start date
+	rruleString = "FREQ=YEARLY"
+	rule, err = util.ParseRRuleFromString(rruleString, time.Date(2020, time.July, 9, 0, 0, 0, 0, location))
+	if err != nil {
+		t.Fatal("Couldn't parse RRULE", err)
+		return
+	}
+
+	standupConfig.RRuleString = rruleString
+	standupConfig.RRule = rule
+	standupScheduleString = standupConfig.GenerateScheduleString()
+	assert.Equal(t, "**Standup Schedule**: Yearly on the 9th of July 10:00 to 15:00", standupScheduleString)
 }
 
 func TestUpdateChannelHeader(t *testing.T) {
@@ -892,7 +992,7 @@ func TestUpdateChannelHeader(t *testing.T) {
 		return
 	}
 
-	monkey.Patch(GetStandupConfig, func(channelID string) (*Config, error) {
+	monkey.Patch(GetStandupConfig, func(channelID, standupID string) (*Config, error) {
 		return &Config{
 			ScheduleEnabled: true,
 			WindowOpenTime:  windowOpenTime,
@@ -923,7 +1023,7 @@ func TestUpdateChannelHeader(t *testing.T) {
 	assert.Nil(t, err, "no error should have been produced")
 	mockAPI.AssertNumberOfCalls(t, "UpdateChannel", 2)
 
-	monkey.Patch(GetStandupConfig, func(channelID string) (*Config, error) {
+	monkey.Patch(GetStandupConfig, func(channelID, standupID string) (*Config, error) {
 		return &Config{
 			ScheduleEnabled: false,
 			WindowOpenTime:  windowOpenTime,
@@ -955,7 +1055,7 @@ func TestUpdateChannelHeader(t *testing.T) {
 	mockAPI.AssertNumberOfCalls(t, "UpdateChannel", 4)
 
 	// no existing channel standup config
-	monkey.Patch(GetStandupConfig, func(channelID string) (*Config, error) {
+	monkey.Patch(GetStandupConfig, func(channelID, standupID string) (*Config, error) {
 		return nil, nil
 	})
 
@@ -992,7 +1092,7 @@ func TestUpdateChannelHeader(t *testing.T) {
 	mockAPI.On("GetChannel", "channel_id_1").Return(&model.Channel{
 		Header: "**Standup Schedule**: Weekly on MO 10:00 to 15:00** ** | user-defined header",
 	}, nil)
-	monkey.Patch(GetStandupConfig, func(channelID string) (*Config, error) {
+	monkey.Patch(GetStandupConfig, func(channelID, standupID string) (*Config, error) {
 		return &Config{
 			ScheduleEnabled: true,
 			WindowOpenTime:  windowOpenTime,
@@ -1019,7 +1119,7 @@ func TestUpdateChannelHeader(t *testing.T) {
 	mockAPI.On("GetChannel", "channel_id_1").Return(&model.Channel{
 		Header: "",
 	}, nil)
-	monkey.Patch(GetStandupConfig, func(channelID string) (*Config, error) {
+	monkey.Patch(GetStandupConfig, func(channelID, standupID string) (*Config, error) {
 		return &Config{
 			ScheduleEnabled: true,
 			WindowOpenTime:  windowOpenTime,
@@ -1042,7 +1142,7 @@ func TestUpdateChannelHeader(t *testing.T) {
 
 func TestUpdateChannelHeader_GetStandupConfig_Error(t *testing.T) {
 	defer TearDown()
-	monkey.Patch(GetStandupConfig, func(channelID string) (*Config, error) {
+	monkey.Patch(GetStandupConfig, func(channelID, standupID string) (*Config, error) {
 		return nil, errors.New("error")
 	})
 
@@ -1069,7 +1169,7 @@ func TestUpdateChannelHeader_GetChannel_Error(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	monkey.Patch(GetStandupConfig, func(channelID string) (*Config, error) {
+	monkey.Patch(GetStandupConfig, func(channelID, standupID string) (*Config, error) {
 		return &Config{
 			ScheduleEnabled: true,
 			WindowOpenTime:  windowOpenTime,
@@ -1111,7 +1211,7 @@ func TestUpdateChannelHeader_UpdateChannel_Error(t *testing.T) {
 		return
 	}
 
-	monkey.Patch(GetStandupConfig, func(channelID string) (*Config, error) {
+	monkey.Patch(GetStandupConfig, func(channelID, standupID string) (*Config, error) {
 		return &Config{
 			ScheduleEnabled: true,
 			WindowOpenTime:  windowOpenTime,
@@ -1158,7 +1258,7 @@ func TestUpdateChannelHeader_ExistingPipeInHeader(t *testing.T) {
 		return
 	}
 
-	monkey.Patch(GetStandupConfig, func(channelID string) (*Config, error) {
+	monkey.Patch(GetStandupConfig, func(channelID, standupID string) (*Config, error) {
 		return &Config{
 			ScheduleEnabled: true,
 			WindowOpenTime:  windowOpenTime,
@@ -1189,7 +1289,7 @@ func TestUpdateChannelHeader_ExistingPipeInHeader(t *testing.T) {
 	assert.Nil(t, err, "no error should have been produced")
 	mockAPI.AssertNumberOfCalls(t, "UpdateChannel", 2)
 
-	monkey.Patch(GetStandupConfig, func(channelID string) (*Config, error) {
+	monkey.Patch(GetStandupConfig, func(channelID, standupID string) (*Config, error) {
 		return &Config{
 			ScheduleEnabled: false,
 			WindowOpenTime:  windowOpenTime,
@@ -1227,7 +1327,7 @@ func TestUpdateChannelHeader_ArchivedChannel(t *testing.T) {
 		DeleteAt: time.Now().Unix(),
 	}, nil)
 
-	monkey.Patch(GetStandupConfig, func(channelID string) (*Config, error) {
+	monkey.Patch(GetStandupConfig, func(channelID, standupID string) (*Config, error) {
 		return nil, nil
 	})
 
@@ -1273,3 +1373,212 @@ func TestArchiveStandupChannels(t *testing.T) {
 
 	assert.Nil(t, ArchiveStandupChannels("channel_1"))
 }
+
+func TestGetUserStandupHistory(t *testing.T) {
+	defer TearDown()
+	baseMock()
+
+	from, _ := otime.ParseDate("2020-07-01", "Asia/Kolkata")
+	to, _ := otime.ParseDate("2020-07-05", "Asia/Kolkata")
+
+	requestedDates := []string{}
+	monkey.Patch(GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*UserStandup, error) {
+		requestedDates = append(requestedDates, date.GetDateString())
+		if date.Day()%2 == 0 {
+			return nil, nil
+		}
+
+		return &UserStandup{
+			UserID:    userID,
+			ChannelID: channelID,
+			Standup: map[string]*[]string{
+				"section_1": {"task_1"},
+			},
+		}, nil
+	})
+
+	history, err := GetUserStandupHistory("user_id", "channel_id", "", from, to)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"20200701", "20200702", "20200703", "20200704", "20200705"}, requestedDates)
+	assert.Equal(t, 3, len(history))
+	assert.Equal(t, "2020-07-01", history[0].Date)
+	assert.Equal(t, "2020-07-03", history[1].Date)
+	assert.Equal(t, "2020-07-05", history[2].Date)
+	assert.Equal(t, "user_id", history[0].UserID)
+
+	// single day range
+	history, err = GetUserStandupHistory("user_id", "channel_id", "", from, from)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(history))
+
+	// dates in wrong order
+	history, err = GetUserStandupHistory("user_id", "channel_id", "", to, from)
+	assert.NotNil(t, err)
+	assert.Nil(t, history)
+
+	// range too large
+	tooLate := otime.OTime{Time: from.AddDate(0, 0, StandupHistoryMaxDays)}
+	history, err = GetUserStandupHistory("user_id", "channel_id", "", from, tooLate)
+	assert.NotNil(t, err)
+	assert.Nil(t, history)
+
+	monkey.Patch(GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*UserStandup, error) {
+		return nil, errors.New("simulated error")
+	})
+
+	history, err = GetUserStandupHistory("user_id", "channel_id", "", from, to)
+	assert.NotNil(t, err)
+	assert.Nil(t, history)
+}
+
+func TestIsStandupMember(t *testing.T) {
+	defer TearDown()
+	baseMock()
+
+	memoryStore := NewMemoryStore()
+	SetStore(memoryStore)
+	defer SetStore(&KVStore{})
+
+	standupConfig := &Config{ChannelID: "channel_id", Members: []string{"user_id_1"}}
+	assert.Nil(t, memoryStore.SetStandupConfigHistory("channel_id", "", []*ConfigVersion{
+		{Version: 1, Config: &Config{ChannelID: "channel_id", Members: []string{"user_id_1", "user_id_2"}}},
+	}))
+
+	for userID, expected := range map[string]bool{"user_id_1": true, "user_id_2": true, "user_id_3": false} {
+		isMember, err := IsStandupMember(standupConfig, userID)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, isMember, userID)
+	}
+}
+
+func TestPublishActiveChannelEvent(t *testing.T) {
+	defer TearDown()
+	mockAPI := baseMock()
+	mockAPI.On("PublishWebSocketEvent", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	SetStore(NewMemoryStore())
+	defer SetStore(&KVStore{})
+
+	enabledConfig := configHistoryTestConfig("section_1")
+	disabledConfig := configHistoryTestConfig("section_1")
+	disabledConfig.StandupID = "retro"
+	disabledConfig.Enabled = false
+	for _, standupConfig := range []*Config{enabledConfig, disabledConfig} {
+		assert.Nil(t, GetStore().SaveStandupConfig(standupConfig))
+		assert.Nil(t, AddStandupChannel(standupConfig.ChannelID, standupConfig.StandupID))
+	}
+
+	// another standup of the channel is still enabled
+	PublishActiveChannelEvent("channel_id", "user_id_1")
+	mockAPI.AssertCalled(t, "PublishWebSocketEvent", "add_active_channel", map[string]interface{}{"channel_id": "channel_id"}, &model.WebsocketBroadcast{UserId: "user_id_1"})
+
+	enabledConfig.Enabled = false
+	assert.Nil(t, GetStore().SaveStandupConfig(enabledConfig))
+	PublishActiveChannelEvent("channel_id", "user_id_1")
+	mockAPI.AssertCalled(t, "PublishWebSocketEvent", "remove_active_channel", map[string]interface{}{"channel_id": "channel_id"}, &model.WebsocketBroadcast{UserId: "user_id_1"})
+}
+
+func TestValidateStandupID(t *testing.T) {
+	assert.Nil(t, ValidateStandupID(DefaultStandupID))
+	assert.Nil(t, ValidateStandupID("retro"))
+	assert.Nil(t, ValidateStandupID("team-1_sync"))
+
+	assert.NotNil(t, ValidateStandupID("Retro"))
+	assert.NotNil(t, ValidateStandupID("-retro"))
+	assert.NotNil(t, ValidateStandupID("retro sync"))
+	assert.NotNil(t, ValidateStandupID(strings.Repeat("a", standupIDMaxLength+1)))
+}
+
+func TestStandupKey(t *testing.T) {
+	assert.Equal(t, "channel_id", StandupKey("channel_id", DefaultStandupID))
+	assert.Equal(t, "channel_id_retro", StandupKey("channel_id", "retro"))
+
+	assert.Equal(t, DefaultStandupID, ParseStandupKey("channel_id", "channel_id"))
+	assert.Equal(t, "retro", ParseStandupKey("channel_id_retro", "channel_id"))
+}
+
+func TestMultipleChannelStandups(t *testing.T) {
+	defer TearDown()
+	mockAPI := baseMock()
+	mockAPI.On("GetChannel", "channel_id").Return(&model.Channel{}, nil)
+	mockAPI.On("UpdateChannel", mock.Anything).Return(nil, nil)
+
+	SetStore(NewMemoryStore())
+	defer SetStore(&KVStore{})
+
+	dailyConfig := configHistoryTestConfig("section_1")
+	retroConfig := configHistoryTestConfig("went_well", "to_improve")
+	retroConfig.StandupID = "retro"
+	retroConfig.Enabled = false
+
+	for _, standupConfig := range []*Config{retroConfig, dailyConfig} {
+		assert.Nil(t, standupConfig.PreSave())
+		assert.Nil(t, standupConfig.IsValid())
+		_, err := SaveStandupConfig(standupConfig, "user_id_1")
+		assert.Nil(t, err)
+		assert.Nil(t, AddStandupChannel(standupConfig.ChannelID, standupConfig.StandupID))
+	}
+
+	channels, err := GetStandupChannels()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"channel_id": "channel_id", "channel_id_retro": "channel_id"}, channels)
+
+	standupConfigs, err := GetChannelStandupConfigs("channel_id")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(standupConfigs))
+	assert.Equal(t, DefaultStandupID, standupConfigs[0].StandupID)
+	assert.Equal(t, []string{"section_1"}, standupConfigs[0].Sections)
+	assert.Equal(t, "retro", standupConfigs[1].StandupID)
+	assert.Equal(t, []string{"went_well", "to_improve"}, standupConfigs[1].Sections)
+
+	active, err := HasEnabledStandup("channel_id")
+	assert.Nil(t, err)
+	assert.True(t, active)
+
+	history, err := GetStandupConfigHistory("channel_id", "retro")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(history))
+
+	assert.Nil(t, SaveUserStandup(&UserStandup{
+		UserID:    "user_id_1",
+		ChannelID: "channel_id",
+		StandupID: "retro",
+		Standup:   map[string]*[]string{"went_well": {"task_1"}},
+	}))
+
+	today := otime.Now("Asia/Kolkata")
+	userStandup, err := GetUserStandup("user_id_1", "channel_id", "retro", today)
+	assert.Nil(t, err)
+	assert.NotNil(t, userStandup)
+
+	userStandup, err = GetUserStandup("user_id_1", "channel_id", DefaultStandupID, today)
+	assert.Nil(t, err)
+	assert.Nil(t, userStandup, "standups of different channel standups should be independent")
+
+	assert.NotNil(t, SaveUserStandup(&UserStandup{
+		UserID:    "user_id_1",
+		ChannelID: "channel_id",
+		StandupID: "not_configured",
+		Standup:   map[string]*[]string{"section_1": {"task_1"}},
+	}))
+}
+
+func TestRemoveChannelHeaderSchedule(t *testing.T) {
+	header := "**Standup Schedule**: Every week on weekdays 10:00 to 11:00 IST** ** | " +
+		"**Standup Schedule (retro)**: Every week on Friday 16:00 to 17:00 IST** ** | user header"
+
+	assert.Equal(
+		t,
+		"**Standup Schedule**: Every week on weekdays 10:00 to 11:00 IST** ** | user header",
+		removeChannelHeaderSchedule(header, "retro"),
+	)
+
+	assert.Equal(
+		t,
+		"**Standup Schedule (retro)**: Every week on Friday 16:00 to 17:00 IST** ** | user header",
+		removeChannelHeaderSchedule(header, DefaultStandupID),
+	)
+
+	assert.Equal(t, "user header", removeChannelHeaderSchedule("user header", DefaultStandupID))
+	assert.Equal(t, "user header", removeChannelHeaderSchedule("user header", "retro"))
+}
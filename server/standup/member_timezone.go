@@ -0,0 +1,62 @@
+package standup
+
+import (
+	"errors"
+	"time"
+
+	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/logger"
+	"github.com/standup-raven/standup-raven/server/otime"
+)
+
+// GetMemberTimezone returns the timezone standup window times apply in for the member.
+// This is the standup timezone unless member timezones are enabled, in which case
+// it's the member's profile timezone, falling back to standup timezone if not set.
+func GetMemberTimezone(standupConfig *Config, userID string) (string, error) {
+	if !standupConfig.MemberTimezonesEnabled {
+		return standupConfig.Timezone, nil
+	}
+
+	user, appErr := config.Mattermost.GetUser(userID)
+	if appErr != nil {
+		logger.Error("Couldn't fetch user", appErr, map[string]interface{}{"userID": userID})
+		return "", errors.New(appErr.Error())
+	}
+
+	timezone := user.GetPreferredTimezone()
+	if timezone == "" {
+		return standupConfig.Timezone, nil
+	}
+
+	if _, err := time.LoadLocation(timezone); err != nil {
+		logger.Warn("Invalid timezone in user profile, using standup timezone instead", err, map[string]interface{}{"userID": userID, "timezone": timezone})
+		return standupConfig.Timezone, nil
+	}
+
+	return timezone, nil
+}
+
+// GetMemberTimezones returns timezone of every standup member, keyed by user ID.
+func GetMemberTimezones(standupConfig *Config) (map[string]string, error) {
+	timezones := make(map[string]string, len(standupConfig.Members))
+	for _, userID := range standupConfig.Members {
+		timezone, err := GetMemberTimezone(standupConfig, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		timezones[userID] = timezone
+	}
+
+	return timezones, nil
+}
+
+// GetMemberStandupDate returns the date member's standup submitted right now belongs to.
+func GetMemberStandupDate(standupConfig *Config, userID string) (otime.OTime, error) {
+	timezone, err := GetMemberTimezone(standupConfig, userID)
+	if err != nil {
+		return otime.OTime{}, err
+	}
+
+	return otime.OTime{Time: standupConfig.StandupDate(otime.Now(timezone).Time)}, nil
+}
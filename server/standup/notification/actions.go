@@ -0,0 +1,83 @@
+package notification
+
+import (
+	"errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/thoas/go-funk"
+
+	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/otime"
+	"github.com/standup-raven/standup-raven/server/standup"
+)
+
+// SkipMemberStandup records that the member skips their standup of the current standup date.
+// Members skipping their standup aren't reminded to fill it and are listed
+// separately in standup report. Returns the date skipped.
+func SkipMemberStandup(standupConfig *standup.Config, userID string) (otime.OTime, error) {
+	if !funk.ContainsString(standupConfig.Members, userID) {
+		return otime.OTime{}, errors.New("you are not a member of this standup")
+	}
+
+	date, err := standup.GetMemberStandupDate(standupConfig, userID)
+	if err != nil {
+		return otime.OTime{}, err
+	}
+
+	if !isStandupDate(standupConfig, date.Time) {
+		return otime.OTime{}, errors.New("there is no standup today")
+	}
+
+	userStandup, err := standup.GetUserStandup(userID, standupConfig.ChannelID, standupConfig.StandupID, date)
+	if err != nil {
+		return otime.OTime{}, err
+	}
+
+	if userStandup != nil {
+		return otime.OTime{}, errors.New("you have already filled your standup")
+	}
+
+	status, err := getNotificationStatusForDate(standupConfig.ChannelID, standupConfig.StandupID, date.GetDateString())
+	if err != nil {
+		return otime.OTime{}, err
+	}
+
+	if status.StandupReportSent {
+		return otime.OTime{}, errors.New("standup report has already been posted")
+	}
+
+	if funk.ContainsString(status.MembersSkipped, userID) {
+		return date, nil
+	}
+
+	status.MembersSkipped = append(status.MembersSkipped, userID)
+	return date, setNotificationStatusForDate(standupConfig.ChannelID, standupConfig.StandupID, date.GetDateString(), status)
+}
+
+// attachReminderActions adds buttons to the reminder post letting the member clicking
+// them open the standup modal or skip their standup of the day.
+func attachReminderActions(post *model.Post, standupConfig *standup.Config) {
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{
+		{
+			Actions: []*model.PostAction{
+				reminderAction(standupConfig, "Fill standup", config.PathActionFillStandup),
+				reminderAction(standupConfig, "Skip today", config.PathActionSkipStandup),
+			},
+		},
+	})
+}
+
+// reminderAction returns a post action button identifying the standup in its context.
+func reminderAction(standupConfig *standup.Config, name, path string) *model.PostAction {
+	return &model.PostAction{
+		Name: name,
+		Type: model.POST_ACTION_TYPE_BUTTON,
+		Integration: &model.PostActionIntegration{
+			URL: config.URLPluginBase + path,
+			Context: map[string]interface{}{
+				"channel_id": standupConfig.ChannelID,
+				"standup_id": standupConfig.StandupID,
+			},
+		},
+	}
+}
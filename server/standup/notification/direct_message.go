@@ -0,0 +1,90 @@
+package notification
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/thoas/go-funk"
+
+	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/logger"
+	"github.com/standup-raven/standup-raven/server/otime"
+	"github.com/standup-raven/standup-raven/server/standup"
+)
+
+// pendingMembers returns IDs of standup members who are yet to fill their standup of the
+// specified date, given notification status of the date. Members out of office on the date
+// or skipping their standup are left out.
+func pendingMembers(standupConfig *standup.Config, date otime.OTime, status *ChannelNotificationStatus) ([]string, error) {
+	outOfOffice, err := standup.GetOutOfOfficeUsers(standupConfig.Members, date)
+	if err != nil {
+		return nil, err
+	}
+
+	var userIDs []string
+	for _, userID := range standupConfig.Members {
+		if outOfOffice[userID] || funk.ContainsString(status.MembersSkipped, userID) {
+			continue
+		}
+
+		userStandup, err := standup.GetUserStandup(userID, standupConfig.ChannelID, standupConfig.StandupID, date)
+		if err != nil {
+			return nil, err
+		}
+
+		if userStandup == nil {
+			userIDs = append(userIDs, userID)
+		}
+	}
+
+	return userIDs, nil
+}
+
+// sendDirectMessageReminders sends the reminder message, a sentence addressed to the members,
+// to each of the specified members as a direct message from the bot. The message mentions
+// standup channel and has the reminder actions. A custom message, if not empty, is sent as is instead.
+// Failing to message a member doesn't stop messaging others.
+func sendDirectMessageReminders(standupConfig *standup.Config, userIDs []string, message, customMessage string) error {
+	if customMessage != "" {
+		message = customMessage
+	} else {
+		channel, appErr := config.Mattermost.GetChannel(standupConfig.ChannelID)
+		if appErr != nil {
+			logger.Error("Couldn't fetch channel", appErr, map[string]interface{}{"channelID": standupConfig.ChannelID})
+			return errors.New(appErr.Error())
+		}
+
+		message = directMessageReminderText(message, channel.Name)
+	}
+
+	botUserID := config.GetConfig().BotUserID
+	for _, userID := range userIDs {
+		directChannel, appErr := config.Mattermost.GetDirectChannel(userID, botUserID)
+		if appErr != nil {
+			logger.Error("Couldn't fetch direct channel with user", appErr, map[string]interface{}{"userID": userID})
+			continue
+		}
+
+		post := &model.Post{
+			ChannelId: directChannel.Id,
+			UserId:    botUserID,
+			Type:      model.POST_DEFAULT,
+			Message:   message,
+		}
+		attachReminderActions(post, standupConfig)
+
+		if _, appErr := config.Mattermost.CreatePost(post); appErr != nil {
+			logger.Error("Couldn't send standup reminder to user", appErr, map[string]interface{}{"channelID": standupConfig.ChannelID, "userID": userID})
+		}
+	}
+
+	return nil
+}
+
+// directMessageReminderText capitalizes the reminder message and
+// mentions the channel before its closing punctuation.
+func directMessageReminderText(message, channelName string) string {
+	end := len(message) - 1
+	return strings.ToUpper(message[:1]) + message[1:end] + " in ~" + channelName + message[end:]
+}
@@ -0,0 +1,102 @@
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/logger"
+	"github.com/standup-raven/standup-raven/server/otime"
+	"github.com/standup-raven/standup-raven/server/standup"
+	"github.com/standup-raven/standup-raven/server/util"
+)
+
+// reportUpdatedFooter is appended to standup report posts regenerated after
+// being posted, with the time they were last updated at.
+const reportUpdatedFooter = "\n\n---\n:pencil2: _Last updated on %s_"
+
+// PostLateStandup posts the user standup as a reply to the standup report if the report
+// of the user's current standup date has already been posted in the channel. The report post
+// is regenerated instead if standup has UpdateReportPost enabled.
+// Standups submitted before the report are part of the report instead.
+func PostLateStandup(userStandup *standup.UserStandup) error {
+	standupConfig, err := standup.GetStandupConfig(userStandup.ChannelID, userStandup.StandupID)
+	if err != nil {
+		return err
+	}
+
+	if standupConfig == nil {
+		return errors.New("standup not configured for channel: " + userStandup.ChannelID)
+	}
+
+	date, err := standup.GetMemberStandupDate(standupConfig, userStandup.UserID)
+	if err != nil {
+		return err
+	}
+
+	status, err := getNotificationStatusForDate(standupConfig.ChannelID, standupConfig.StandupID, date.GetDateString())
+	if err != nil {
+		return err
+	}
+
+	// reports posted before report post IDs were stored can't be replied to
+	if !status.StandupReportSent || status.ReportPostID == "" {
+		return nil
+	}
+
+	if standupConfig.UpdateReportPost {
+		return updateStandupReport(standupConfig, date, status.ReportPostID)
+	}
+
+	userDisplayName, err := getUserDisplayName(userStandup.UserID)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Posting late standup update for channel: "+standupConfig.ChannelID, nil)
+
+	header := fmt.Sprintf("#### :alarm_clock: Late update from %s %s", util.UserIcon(userStandup.UserID), userDisplayName)
+	post := &model.Post{
+		ChannelId: standupConfig.ChannelID,
+		UserId:    config.GetConfig().BotUserID,
+		RootId:    status.ReportPostID,
+		Message:   header + "\n\n" + userStandupText(standupConfig, userStandup),
+	}
+
+	if _, appErr := config.Mattermost.CreatePost(post); appErr != nil {
+		return errors.New(appErr.Error())
+	}
+
+	return nil
+}
+
+// updateStandupReport regenerates the standup report of the date in the report post,
+// noting when it was last updated.
+func updateStandupReport(standupConfig *standup.Config, date otime.OTime, reportPostID string) error {
+	reportPost, appErr := config.Mattermost.GetPost(reportPostID)
+	if appErr != nil {
+		return errors.New(appErr.Error())
+	}
+
+	post, err := generateChannelStandupReport(standupConfig, standupConfig.ChannelID, date)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Updating standup report for channel: "+standupConfig.ChannelID, nil)
+
+	message := strings.TrimRight(post.Message, "\n")
+	if strings.HasPrefix(reportPost.Message, delayedReportNotice) {
+		message = delayedReportNotice + message
+	}
+
+	reportPost.Message = message + fmt.Sprintf(reportUpdatedFooter, otime.Now(standupConfig.Timezone).Format("2 Jan 2006 15:04 MST"))
+	if _, appErr := config.Mattermost.UpdatePost(reportPost); appErr != nil {
+		return errors.New(appErr.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,331 @@
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/thoas/go-funk"
+
+	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/logger"
+	"github.com/standup-raven/standup-raven/server/otime"
+	"github.com/standup-raven/standup-raven/server/standup"
+)
+
+// memberTimezoneReportLookbackDays is the number of days before today, in standup timezone,
+// checked for a pending standup report. The last member's window can close a day or two
+// later in standup timezone when members are spread across timezones.
+const memberTimezoneReportLookbackDays = 2
+
+// sendMemberTimezoneNotificationsAndReports sends reminders and reports of all standups
+// with member timezones enabled. Window times of such standups apply in each member's
+// timezone, so members are reminded individually at their local time and the report
+// is sent once the last member's window has closed.
+func sendMemberTimezoneNotificationsAndReports(channels map[string]string) error {
+	for standupKey, channelID := range channels {
+		standupConfig, err := standup.GetStandupConfig(channelID, standup.ParseStandupKey(standupKey, channelID))
+		if err != nil {
+			return err
+		}
+
+		if standupConfig == nil || !standupConfig.Enabled || !standupConfig.MemberTimezonesEnabled {
+			continue
+		}
+
+		memberTimezones, err := standup.GetMemberTimezones(standupConfig)
+		if err != nil {
+			return err
+		}
+
+		if err := sendMemberReminders(standupConfig, memberTimezones); err != nil {
+			return err
+		}
+
+		if err := sendMemberTimezoneStandupReport(standupConfig, memberTimezones); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendMemberReminders sends window open and close reminders to members whose window
+// is currently open in their timezone. Members are grouped by their current standup date
+// as reminders are tracked in notification status of that date.
+func sendMemberReminders(standupConfig *standup.Config, memberTimezones map[string]string) error {
+	membersByDate := map[string][]string{}
+	for _, userID := range standupConfig.Members {
+		date := otime.OTime{Time: standupConfig.StandupDate(otime.Now(memberTimezones[userID]).Time)}.GetDateString()
+		membersByDate[date] = append(membersByDate[date], userID)
+	}
+
+	dates := make([]string, 0, len(membersByDate))
+	for date := range membersByDate {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+
+	for _, date := range dates {
+		if err := sendMemberRemindersForDate(standupConfig, memberTimezones, membersByDate[date], date); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func sendMemberRemindersForDate(standupConfig *standup.Config, memberTimezones map[string]string, userIDs []string, date string) error {
+	status, err := getNotificationStatusForDate(standupConfig.ChannelID, standupConfig.StandupID, date)
+	if err != nil {
+		return err
+	}
+
+	outOfOffice, err := standup.GetOutOfOffice(userIDs)
+	if err != nil {
+		return err
+	}
+
+	// members who need a reminder, and members done with their due reminder
+	// along with the reminder's index in standup's reminder schedule
+	var windowOpenPending, windowClosePending []string
+	done := map[string]bool{}
+	steps := map[string]int{}
+
+	// standup date of the members, same for all of them
+	var standupDate otime.OTime
+
+	for _, userID := range userIDs {
+		now := otime.Now(memberTimezones[userID])
+		standupDate = otime.OTime{Time: standupConfig.StandupDate(now.Time)}
+		if !isStandupDate(standupConfig, standupDate.Time) {
+			continue
+		}
+
+		windowOpen, windowClose := getMemberWindow(standupConfig, standupDate.Time, memberTimezones[userID])
+		if now.Before(windowOpen) || !now.Before(windowClose) {
+			continue
+		}
+
+		step, reminder := dueReminder(standupConfig, windowOpen, windowClose, now.Time, status.MemberRemindersSent[userID])
+		if step < 0 {
+			continue
+		}
+		steps[userID] = step
+
+		if outOfOffice[userID].Includes(standupDate) || funk.ContainsString(status.MembersSkipped, userID) {
+			done[userID] = true
+			continue
+		}
+
+		if reminder.IsWindowOpen() {
+			if standupConfig.WindowOpenReminderEnabled {
+				windowOpenPending = append(windowOpenPending, userID)
+			} else {
+				done[userID] = true
+			}
+
+			continue
+		}
+
+		userStandup, err := standup.GetUserStandup(userID, standupConfig.ChannelID, standupConfig.StandupID, standupDate)
+		if err != nil {
+			return err
+		}
+
+		if standupConfig.WindowCloseReminderEnabled && userStandup == nil {
+			windowClosePending = append(windowClosePending, userID)
+		} else {
+			done[userID] = true
+		}
+	}
+
+	if len(windowOpenPending) > 0 {
+		if err := sendMemberReminder(standupConfig, standupDate, windowOpenPending, "please start filling your "+standupName(standupConfig.StandupID)+"!", standupConfig.WindowOpenMessage); err != nil {
+			logger.Error("Error sending window open notification for channel", err, map[string]interface{}{"channelID": standupConfig.ChannelID})
+		} else {
+			for _, userID := range windowOpenPending {
+				done[userID] = true
+			}
+		}
+	}
+
+	if len(windowClosePending) > 0 {
+		if err := sendMemberReminder(standupConfig, standupDate, windowClosePending, "a gentle reminder to fill your "+standupName(standupConfig.StandupID)+".", standupConfig.WindowCloseMessage); err != nil {
+			logger.Error("Error sending window close notification for channel", err, map[string]interface{}{"channelID": standupConfig.ChannelID})
+		} else {
+			for _, userID := range windowClosePending {
+				done[userID] = true
+			}
+		}
+	}
+
+	if len(done) == 0 {
+		return nil
+	}
+
+	if status.MemberRemindersSent == nil {
+		status.MemberRemindersSent = map[string][]int{}
+	}
+
+	for userID := range done {
+		status.MemberRemindersSent[userID] = append(status.MemberRemindersSent[userID], steps[userID])
+	}
+
+	return setNotificationStatusForDate(standupConfig.ChannelID, standupConfig.StandupID, date, status)
+}
+
+// sendMemberReminder posts the reminder message in standup channel mentioning the specified members,
+// or sends it to each of them directly if standup has direct message reminders enabled.
+// The message is rendered from messageTemplate instead, if standup has one.
+// Unlike other reminders, these aren't deleted when the report is sent as reminders
+// of the next standup may already have been sent to some members by then.
+func sendMemberReminder(standupConfig *standup.Config, date otime.OTime, userIDs []string, message, messageTemplate string) error {
+	customMessage := customReminderMessage(standupConfig, messageTemplate, date, userIDs)
+	if standupConfig.DirectMessageReminders {
+		return sendDirectMessageReminders(standupConfig, userIDs, message, customMessage)
+	}
+
+	if customMessage != "" {
+		return createMemberReminderPost(standupConfig, customMessage)
+	}
+
+	usernames := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		user, appErr := config.Mattermost.GetUser(userID)
+		if appErr != nil {
+			logger.Error("Couldn't find user with user ID", appErr, map[string]interface{}{"userID": userID})
+			return errors.New(appErr.Error())
+		}
+
+		usernames = append(usernames, user.Username)
+	}
+	sort.Strings(usernames)
+
+	return createMemberReminderPost(standupConfig, fmt.Sprintf("@%s - %s", strings.Join(usernames, ", @"), message))
+}
+
+func createMemberReminderPost(standupConfig *standup.Config, message string) error {
+	post := &model.Post{
+		ChannelId: standupConfig.ChannelID,
+		UserId:    config.GetConfig().BotUserID,
+		Type:      model.POST_DEFAULT,
+		Message:   message,
+	}
+	attachReminderActions(post, standupConfig)
+
+	if _, appErr := config.Mattermost.CreatePost(post); appErr != nil {
+		return errors.New(appErr.Error())
+	}
+
+	return nil
+}
+
+// sendMemberTimezoneStandupReport sends standup report of every recent standup date
+// whose window has closed for all members. Reports due for more than
+// a day are not sent, same as for standups without member timezones.
+func sendMemberTimezoneStandupReport(standupConfig *standup.Config, memberTimezones map[string]string) error {
+	location, err := time.LoadLocation(standupConfig.Timezone)
+	if err != nil {
+		return err
+	}
+
+	now := otime.Now(standupConfig.Timezone)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location)
+
+	for days := memberTimezoneReportLookbackDays; days >= 0; days-- {
+		date := today.AddDate(0, 0, -days)
+		if !isStandupDate(standupConfig, date) {
+			continue
+		}
+
+		lastWindowClose := getLastMemberWindowClose(standupConfig, date, memberTimezones)
+		if now.Before(lastWindowClose) || now.After(lastWindowClose.AddDate(0, 0, 1)) {
+			continue
+		}
+
+		dateString := otime.OTime{Time: date}.GetDateString()
+		status, err := getNotificationStatusForDate(standupConfig.ChannelID, standupConfig.StandupID, dateString)
+		if err != nil {
+			return err
+		}
+
+		if status.StandupReportSent {
+			continue
+		}
+
+		post, err := generateChannelStandupReport(standupConfig, standupConfig.ChannelID, otime.OTime{Time: date})
+		if err != nil {
+			return err
+		}
+
+		reportPost, appErr := config.Mattermost.CreatePost(post)
+		if appErr != nil {
+			logger.Error("Couldn't create standup report post", appErr, nil)
+			return errors.New(appErr.Error())
+		}
+
+		sendReportCopies(standupConfig, post, "")
+
+		if err := deleteReminderPosts(standupConfig.ChannelID, standupConfig.StandupID); err != nil {
+			// log and continue. This shouldn't affect primary flow
+			logger.Error("Error occurred while deleting reminder posts for channel: "+standupConfig.ChannelID, err, nil)
+		}
+
+		status.StandupReportSent = true
+		status.ReportPostID = reportPost.Id
+		if err := setNotificationStatusForDate(standupConfig.ChannelID, standupConfig.StandupID, dateString, status); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getMemberWindow returns standup window on the calendar date of the specified time
+// in member's timezone.
+func getMemberWindow(standupConfig *standup.Config, date time.Time, timezone string) (time.Time, time.Time) {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		location = date.Location()
+	}
+
+	return standupConfig.Window(time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, location))
+}
+
+// getLastMemberWindowClose returns the time standup window closes on
+// the specified date for the member who is the last to close it.
+func getLastMemberWindowClose(standupConfig *standup.Config, date time.Time, memberTimezones map[string]string) time.Time {
+	_, lastWindowClose := getMemberWindow(standupConfig, date, standupConfig.Timezone)
+	if len(memberTimezones) > 0 {
+		lastWindowClose = time.Time{}
+	}
+
+	for _, timezone := range memberTimezones {
+		if _, windowClose := getMemberWindow(standupConfig, date, timezone); windowClose.After(lastWindowClose) {
+			lastWindowClose = windowClose
+		}
+	}
+
+	return lastWindowClose
+}
+
+func getNotificationStatusForDate(channelID, standupID, date string) (*ChannelNotificationStatus, error) {
+	status, err := standup.GetStore().GetNotificationStatus(channelID, standupID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == nil {
+		return &ChannelNotificationStatus{}, nil
+	}
+
+	return status, nil
+}
+
+func setNotificationStatusForDate(channelID, standupID, date string, status *ChannelNotificationStatus) error {
+	return standup.GetStore().SetNotificationStatus(channelID, standupID, date, status)
+}
@@ -0,0 +1,140 @@
+package notification
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest/mock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/otime"
+	"github.com/standup-raven/standup-raven/server/standup"
+	"github.com/standup-raven/standup-raven/server/util"
+)
+
+// fixedOffsetTimezone returns a timezone in which the current
+// local time is within the specified hour of the day.
+func fixedOffsetTimezone(hour int) string {
+	offset := (hour - time.Now().UTC().Hour() + 24) % 24
+	if offset > 12 {
+		offset -= 24
+	}
+
+	// sign of Etc/GMT timezones is inverted
+	if offset > 0 {
+		return fmt.Sprintf("Etc/GMT-%d", offset)
+	} else if offset < 0 {
+		return fmt.Sprintf("Etc/GMT+%d", -offset)
+	}
+	return "Etc/GMT"
+}
+
+func memberTimezoneUser(username, timezone string) *model.User {
+	return &model.User{
+		Username: username,
+		Timezone: map[string]string{
+			"useAutomaticTimezone": "false",
+			"manualTimezone":       timezone,
+		},
+	}
+}
+
+func TestSendNotificationsAndReports_MemberTimezones(t *testing.T) {
+	defer TearDown()
+	mockAPI := setUp()
+	baseMock(mockAPI)
+	mockAPI.On("CreatePost", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{Id: "post_id"}, nil)
+	mockAPI.On("DeletePost", mock.AnythingOfType("string")).Return(nil)
+
+	// user_id_1's window is open while user_id_2's window opens three hours later
+	mockAPI.On("GetUser", "user_id_1").Return(memberTimezoneUser("john", fixedOffsetTimezone(10)), nil)
+	mockAPI.On("GetUser", "user_id_2").Return(memberTimezoneUser("jane", fixedOffsetTimezone(7)), nil)
+
+	memoryStore := standup.NewMemoryStore()
+	standup.SetStore(memoryStore)
+	defer standup.SetStore(&standup.KVStore{})
+
+	parsedRRule, err := util.ParseRRuleFromString(rruleString, time.Now().Add(-5*24*time.Hour))
+	if err != nil {
+		t.Fatal("Couldn't parse RRULE", err)
+		return
+	}
+
+	windowOpenTime, _ := otime.Parse("10:00")
+	windowCloseTime, _ := otime.Parse("12:00")
+
+	assert.Nil(t, memoryStore.SetStandupChannels(map[string]string{"channel_1": "channel_1"}))
+	assert.Nil(t, memoryStore.SaveStandupConfig(&standup.Config{
+		ChannelID:                  "channel_1",
+		WindowOpenTime:             windowOpenTime,
+		WindowCloseTime:            windowCloseTime,
+		Enabled:                    true,
+		Members:                    []string{"user_id_1", "user_id_2"},
+		ReportFormat:               config.ReportFormatUserAggregated,
+		Sections:                   []string{"section 1"},
+		Timezone:                   "Asia/Kolkata",
+		WindowOpenReminderEnabled:  true,
+		WindowCloseReminderEnabled: true,
+		MemberTimezonesEnabled:     true,
+		RRuleString:                rruleString,
+		RRule:                      parsedRRule,
+	}))
+
+	assert.Nil(t, SendNotificationsAndReports())
+	assert.Equal(t, []string{"@john - please start filling your standup!"}, getReminderMessages(mockAPI))
+
+	status, err := memoryStore.GetNotificationStatus("channel_1", "", otime.Now(fixedOffsetTimezone(10)).GetDateString())
+	assert.Nil(t, err)
+	assert.Equal(t, map[string][]int{"user_id_1": {0}}, status.MemberRemindersSent)
+	assert.False(t, status.StandupReportSent, "report shouldn't be sent before every member's window closes")
+
+	// members are reminded only once
+	assert.Nil(t, SendNotificationsAndReports())
+	assert.Equal(t, 1, len(getReminderMessages(mockAPI)))
+}
+
+// getReminderMessages returns messages of all reminder posts created, skipping
+// reports of previous days that may have been sent depending on current time.
+func getReminderMessages(mockAPI *plugintest.API) []string {
+	var messages []string
+	for _, call := range mockAPI.Calls {
+		if call.Method != "CreatePost" {
+			continue
+		}
+
+		if message := call.Arguments.Get(0).(*model.Post).Message; strings.HasPrefix(message, "@") {
+			messages = append(messages, message)
+		}
+	}
+
+	return messages
+}
+
+func TestGetLastMemberWindowClose(t *testing.T) {
+	windowOpenTime, _ := otime.Parse("10:00")
+	windowCloseTime, _ := otime.Parse("11:00")
+	standupConfig := &standup.Config{
+		WindowOpenTime:  windowOpenTime,
+		WindowCloseTime: windowCloseTime,
+		Timezone:        "Asia/Kolkata",
+	}
+
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	date := time.Date(2020, 10, 1, 0, 0, 0, 0, location)
+
+	lastWindowClose := getLastMemberWindowClose(standupConfig, date, map[string]string{
+		"user_id_1": "Asia/Kolkata",
+		"user_id_2": "America/New_York",
+		"user_id_3": "UTC",
+	})
+	assert.True(t, lastWindowClose.Equal(time.Date(2020, 10, 1, 15, 0, 0, 0, time.UTC)))
+
+	// standup timezone is used when there are no members
+	lastWindowClose = getLastMemberWindowClose(standupConfig, date, map[string]string{})
+	assert.True(t, lastWindowClose.Equal(time.Date(2020, 10, 1, 11, 0, 0, 0, location)))
+}
@@ -0,0 +1,131 @@
+package notification
+
+import (
+	"errors"
+	"time"
+
+	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/logger"
+	"github.com/standup-raven/standup-raven/server/otime"
+	"github.com/standup-raven/standup-raven/server/standup"
+)
+
+// missedReportLookbackDays is the number of days before the current standup date
+// checked for standup reports missed while the plugin wasn't running.
+// Reports older than this are no longer useful and are never sent.
+const missedReportLookbackDays = 7
+
+const delayedReportNotice = ":hourglass: **Delayed report** - this report couldn't be posted when the standup window closed.\n\n"
+
+// sendMissedStandupReports sends reports of past standup dates which were missed,
+// such as when the plugin wasn't running when the standup window closed.
+// Standups with member timezones enabled look back for pending reports on their own.
+func sendMissedStandupReports(channels map[string]string) error {
+	for standupKey, channelID := range channels {
+		standupConfig, err := standup.GetStandupConfig(channelID, standup.ParseStandupKey(standupKey, channelID))
+		if err != nil {
+			return err
+		}
+
+		if standupConfig == nil || standupConfig.MemberTimezonesEnabled {
+			continue
+		}
+
+		if err := sendMissedStandupReport(standupConfig, channelID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendMissedStandupReport sends a delayed report for every standup date after
+// the last processed one whose report wasn't sent. Only reports are caught up,
+// reminders of past dates are stale and are never sent.
+// The last processed date is cleared while standup is disabled, so nothing is
+// caught up for dates standup was disabled on, same as on the first run.
+func sendMissedStandupReport(standupConfig *standup.Config, channelID string) error {
+	store := standup.GetStore()
+	lastProcessed, err := store.GetLastProcessedOccurrence(channelID, standupConfig.StandupID)
+	if err != nil {
+		return err
+	}
+
+	if !standupConfig.Enabled {
+		if lastProcessed == "" {
+			return nil
+		}
+
+		return store.SetLastProcessedOccurrence(channelID, standupConfig.StandupID, "")
+	}
+
+	currentStandupDate := standupConfig.CurrentStandupDate().Time
+	previousStandupDate := standupConfig.PreviousStandupDate(currentStandupDate)
+	if previousStandupDate.IsZero() {
+		return nil
+	}
+
+	previousStandupDateString := previousStandupDate.Format(otime.LayoutISODate)
+	if lastProcessed == previousStandupDateString {
+		return nil
+	}
+
+	if lastProcessed != "" {
+		lastProcessedDate, err := otime.ParseDate(lastProcessed, standupConfig.Timezone)
+		if err != nil {
+			return err
+		}
+
+		after := lastProcessedDate.Time
+		if lookbackStart := currentStandupDate.AddDate(0, 0, -missedReportLookbackDays-1); after.Before(lookbackStart) {
+			after = lookbackStart
+		}
+
+		for _, date := range standupConfig.StandupDatesBetween(after, currentStandupDate) {
+			if err := sendDelayedStandupReport(standupConfig, channelID, date); err != nil {
+				return err
+			}
+		}
+	}
+
+	return store.SetLastProcessedOccurrence(channelID, standupConfig.StandupID, previousStandupDateString)
+}
+
+// sendDelayedStandupReport sends report of the specified standup date, marked as delayed,
+// unless it has already been sent.
+func sendDelayedStandupReport(standupConfig *standup.Config, channelID string, date time.Time) error {
+	dateString := otime.OTime{Time: date}.GetDateString()
+	status, err := getNotificationStatusForDate(channelID, standupConfig.StandupID, dateString)
+	if err != nil {
+		return err
+	}
+
+	if status.StandupReportSent {
+		return nil
+	}
+
+	logger.Info("Sending delayed standup report for channel: "+channelID+" standup: "+standupConfig.StandupID+" time: "+dateString, nil)
+
+	post, err := generateChannelStandupReport(standupConfig, channelID, otime.OTime{Time: date})
+	if err != nil {
+		return err
+	}
+
+	post.Message = delayedReportNotice + post.Message
+	reportPost, appErr := config.Mattermost.CreatePost(post)
+	if appErr != nil {
+		logger.Error("Couldn't create delayed standup report post", appErr, nil)
+		return errors.New(appErr.Error())
+	}
+
+	sendReportCopies(standupConfig, post, "")
+
+	if err := deleteReminderPosts(channelID, standupConfig.StandupID); err != nil {
+		// log and continue. This shouldn't affect primary flow
+		logger.Error("Error occurred while deleting reminder posts for channel: "+channelID, err, nil)
+	}
+
+	status.StandupReportSent = true
+	status.ReportPostID = reportPost.Id
+	return setNotificationStatusForDate(channelID, standupConfig.StandupID, dateString, status)
+}
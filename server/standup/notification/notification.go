@@ -1,7 +1,6 @@
 package notification
 
 import (
-	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
@@ -9,6 +8,7 @@ import (
 
 	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
 
 	"github.com/standup-raven/standup-raven/server/config"
 	"github.com/standup-raven/standup-raven/server/logger"
@@ -17,11 +17,7 @@ import (
 	"github.com/standup-raven/standup-raven/server/util"
 )
 
-type ChannelNotificationStatus struct {
-	WindowOpenNotificationSent  bool `json:"windowOpenNotificationSent"`
-	WindowCloseNotificationSent bool `json:"windowCloseNotificationSent"`
-	StandupReportSent           bool `json:"standupReportSent"`
-}
+type ChannelNotificationStatus = standup.ChannelNotificationStatus
 
 const (
 	// statuses for standup notification in a channel
@@ -34,45 +30,61 @@ const (
 	ReportVisibilityPrivate = "private"
 )
 
+// channelStandup identifies a single standup of a channel.
+type channelStandup struct {
+	ChannelID string
+	StandupID string
+
+	// index of the reminder to send in standup's reminder schedule,
+	// for standups pending a reminder
+	ReminderStep int
+}
+
 // SendNotificationsAndReports checks for all standup channels and sends
 // notifications and standup reports as needed.
 // This is the entry point of the whole standup cycle.
 func SendNotificationsAndReports() error {
-	channelIDs, err := standup.GetStandupChannels()
+	channels, err := standup.GetStandupChannels()
 	if err != nil {
 		return err
 	}
 
-	pendingWindowOpenNotificationChannelIDs,
-		pendingWindowCloseNotificationChannelIDs,
-		pendingStandupReportChannelIDs,
-		err := filterChannelNotification(channelIDs)
+	pendingWindowOpenNotificationStandups,
+		pendingWindowCloseNotificationStandups,
+		pendingStandupReportStandups,
+		err := filterChannelNotification(channels)
 
 	if err != nil {
 		return err
 	}
 
-	sendWindowOpenNotification(pendingWindowOpenNotificationChannelIDs)
-	if err := sendWindowCloseNotification(pendingWindowCloseNotificationChannelIDs); err != nil {
+	sendWindowOpenNotification(pendingWindowOpenNotificationStandups)
+	if err := sendWindowCloseNotification(pendingWindowCloseNotificationStandups); err != nil {
+		return err
+	}
+	if err := sendAllStandupReport(pendingStandupReportStandups); err != nil {
+		return err
+	}
+	if err := sendMemberTimezoneNotificationsAndReports(channels); err != nil {
 		return err
 	}
-	if err := sendAllStandupReport(pendingStandupReportChannelIDs); err != nil {
+	if err := sendMissedStandupReports(channels); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func sendAllStandupReport(channelIDs []string) error {
-	for _, channelID := range channelIDs {
-		standupConfig, err := standup.GetStandupConfig(channelID)
+func sendAllStandupReport(standups []channelStandup) error {
+	for _, s := range standups {
+		standupConfig, err := standup.GetStandupConfig(s.ChannelID, s.StandupID)
 		if err != nil {
 			return err
 		}
 		if standupConfig == nil {
-			return errors.New("standup not configured for channel: " + channelID)
+			return errors.New("standup not configured for channel: " + s.ChannelID)
 		}
-		standupReportError := SendStandupReport([]string{channelID}, otime.Now(standupConfig.Timezone), ReportVisibilityPublic, "", true)
+		standupReportError := SendStandupReport([]string{s.ChannelID}, s.StandupID, standupConfig.CurrentStandupDate(), ReportVisibilityPublic, "", true)
 		if standupReportError != nil {
 			return standupReportError
 		}
@@ -80,41 +92,33 @@ func sendAllStandupReport(channelIDs []string) error {
 	return nil
 }
 
-// GetNotificationStatus gets the notification status for specified channel
-func GetNotificationStatus(channelID string) (*ChannelNotificationStatus, error) {
-	logger.Debug(fmt.Sprintf("Fetching notification status for channel: %s", channelID), nil)
-	standupConfig, err := standup.GetStandupConfig(channelID)
+// GetNotificationStatus gets the notification status for specified channel standup
+func GetNotificationStatus(channelID, standupID string) (*ChannelNotificationStatus, error) {
+	logger.Debug(fmt.Sprintf("Fetching notification status for channel: %s, standup: %s", channelID, standupID), nil)
+	standupConfig, err := standup.GetStandupConfig(channelID, standupID)
 	if err != nil {
 		return nil, err
 	}
 	if standupConfig == nil {
 		return nil, errors.New("standup not configured for channel: " + channelID)
 	}
-	key := fmt.Sprintf("%s_%s_%s", config.CacheKeyPrefixNotificationStatus, channelID, util.GetCurrentDateString(standupConfig.Timezone))
-	data, appErr := config.Mattermost.KVGet(util.GetKeyHash(key))
-	if appErr != nil {
-		logger.Error("Couldn't get notification status from KV store", appErr, nil)
-		return nil, errors.New(appErr.Error())
-	} else if len(data) == 0 {
-		return &ChannelNotificationStatus{}, nil
-	}
-
-	status := &ChannelNotificationStatus{}
-	if err := json.Unmarshal(data, status); err != nil {
-		logger.Error("Couldn't unmarshal notification status data into struct", err, map[string]interface{}{"channelID": channelID, "data": string(data)})
+	status, err := standup.GetStore().GetNotificationStatus(channelID, standupID, standupConfig.CurrentStandupDate().GetDateString())
+	if err != nil {
 		return nil, err
+	} else if status == nil {
+		return &ChannelNotificationStatus{}, nil
 	}
 
 	logger.Debug(fmt.Sprintf("notification status for channel: %s, %v", channelID, status), nil)
 	return status, nil
 }
 
-// SendStandupReport sends standup report for all channel IDs specified
-func SendStandupReport(channelIDs []string, date otime.OTime, visibility string, userID string, updateStatus bool) error {
+// SendStandupReport sends report of the specified standup for all channel IDs specified
+func SendStandupReport(channelIDs []string, standupID string, date otime.OTime, visibility string, userID string, updateStatus bool) error {
 	for _, channelID := range channelIDs {
-		logger.Info("Sending standup report for channel: "+channelID+" time: "+date.GetDateString(), nil)
+		logger.Info("Sending standup report for channel: "+channelID+" standup: "+standupID+" time: "+date.GetDateString(), nil)
 
-		standupConfig, err := standup.GetStandupConfig(channelID)
+		standupConfig, err := standup.GetStandupConfig(channelID, standupID)
 		if err != nil {
 			return err
 		}
@@ -123,73 +127,46 @@ func SendStandupReport(channelIDs []string, date otime.OTime, visibility string,
 			return errors.New("standup not configured for channel: " + channelID)
 		}
 
-		// standup of all channel standup members
-		var members []*standup.UserStandup
-
-		// names of channel standup members who haven't yet submitted their standup
-		var membersNoStandup []string
-		for _, userID := range standupConfig.Members {
-			userStandup, err := standup.GetUserStandup(userID, channelID, date)
-			if err != nil {
-				return err
-			} else if userStandup == nil {
-				// if user has not submitted standup
-				logger.Info("Could not fetch standup for user: "+userID, nil)
-
-				user, appErr := config.Mattermost.GetUser(userID)
-				if appErr != nil {
-					logger.Error("Couldn't fetch user", appErr, map[string]interface{}{"userID": userID})
-					return errors.New(appErr.Error())
-				}
-
-				membersNoStandup = append(membersNoStandup, user.Username)
-
-				continue
-			}
-
-			members = append(members, userStandup)
-		}
-
-		members, err = sortUserStandups(members)
-		if err != nil {
-			return err
-		}
-
-		post, err := generateReport(
-			standupConfig,
-			members,
-			membersNoStandup,
-			channelID,
-			date,
-		)
-
+		post, err := generateChannelStandupReport(standupConfig, channelID, date)
 		if err != nil {
 			return err
 		}
 
+		var reportPost *model.Post
 		if visibility == ReportVisibilityPrivate {
 			config.Mattermost.SendEphemeralPost(userID, post)
 		} else {
-			_, appErr := config.Mattermost.CreatePost(post)
+			var appErr *model.AppError
+			reportPost, appErr = config.Mattermost.CreatePost(post)
 			if appErr != nil {
 				logger.Error("Couldn't create standup report post", appErr, nil)
 				return errors.New(appErr.Error())
 			}
+
+			// copies are sent only with the scheduled report so that
+			// re-running the report doesn't send them again
+			if updateStatus {
+				sendReportCopies(standupConfig, post, userID)
+			}
 		}
 
-		if err := deleteReminderPosts(channelID); err != nil {
+		if err := deleteReminderPosts(channelID, standupID); err != nil {
 			// log and continue. This shouldn't affect primary flow
 			logger.Error("Error occurred while deleting reminder posts for channel: "+channelID, err, nil)
 		}
 
 		if updateStatus {
-			notificationStatus, err := GetNotificationStatus(channelID)
+			notificationStatus, err := GetNotificationStatus(channelID, standupID)
 			if err != nil {
 				continue
 			}
 
 			notificationStatus.StandupReportSent = true
-			if err := SetNotificationStatus(channelID, notificationStatus); err != nil {
+			if reportPost != nil {
+				notificationStatus.ReportPostID = reportPost.Id
+			}
+
+			if err := SetNotificationStatus(channelID, standupID, notificationStatus); err != nil {
 				return err
 			}
 		}
@@ -198,10 +175,83 @@ func SendStandupReport(channelIDs []string, date otime.OTime, visibility string,
 	return nil
 }
 
+// generateChannelStandupReport generates report of the specified channel standup
+// from standups submitted by its members on the specified date.
+func generateChannelStandupReport(standupConfig *standup.Config, channelID string, date otime.OTime) (*model.Post, error) {
+	// standup of all channel standup members
+	var members []*standup.UserStandup
+
+	// names of channel standup members who haven't yet submitted their standup,
+	// and of those who are out of office or skipped their standup
+	var membersNoStandup, membersOutOfOffice, membersSkipped []string
+
+	outOfOffice, err := standup.GetOutOfOfficeUsers(standupConfig.Members, date)
+	if err != nil {
+		return nil, err
+	}
+
+	// members who skipped their standup are only looked up if someone hasn't submitted it
+	var status *ChannelNotificationStatus
+
+	for _, userID := range standupConfig.Members {
+		userStandup, err := standup.GetUserStandup(userID, channelID, standupConfig.StandupID, date)
+		if err != nil {
+			return nil, err
+		} else if userStandup == nil {
+			// if user has not submitted standup
+			logger.Info("Could not fetch standup for user: "+userID, nil)
+
+			user, appErr := config.Mattermost.GetUser(userID)
+			if appErr != nil {
+				logger.Error("Couldn't fetch user", appErr, map[string]interface{}{"userID": userID})
+				return nil, errors.New(appErr.Error())
+			}
+
+			if outOfOffice[userID] {
+				membersOutOfOffice = append(membersOutOfOffice, user.Username)
+				continue
+			}
+
+			if status == nil {
+				if status, err = getNotificationStatusForDate(channelID, standupConfig.StandupID, date.GetDateString()); err != nil {
+					return nil, err
+				}
+			}
+
+			if funk.ContainsString(status.MembersSkipped, userID) {
+				membersSkipped = append(membersSkipped, user.Username)
+			} else {
+				membersNoStandup = append(membersNoStandup, user.Username)
+			}
+
+			continue
+		}
+
+		members = append(members, userStandup)
+	}
+
+	members, err = sortUserStandups(members)
+	if err != nil {
+		return nil, err
+	}
+
+	return generateReport(
+		standupConfig,
+		members,
+		membersNoStandup,
+		membersOutOfOffice,
+		membersSkipped,
+		channelID,
+		date,
+	)
+}
+
 func generateReport(
 	standupConfig *standup.Config,
 	members []*standup.UserStandup,
 	membersNoStandup []string,
+	membersOutOfOffice []string,
+	membersSkipped []string,
 	channelID string,
 	date otime.OTime,
 ) (*model.Post, error) {
@@ -210,9 +260,11 @@ func generateReport(
 
 	switch standupConfig.ReportFormat {
 	case config.ReportFormatTypeAggregated:
-		post, err = generateTypeAggregatedStandupReport(standupConfig, members, membersNoStandup, channelID, date)
+		post, err = generateTypeAggregatedStandupReport(standupConfig, members, membersNoStandup, membersOutOfOffice, membersSkipped, channelID, date)
 	case config.ReportFormatUserAggregated:
-		post, err = generateUserAggregatedStandupReport(standupConfig, members, membersNoStandup, channelID, date)
+		post, err = generateUserAggregatedStandupReport(standupConfig, members, membersNoStandup, membersOutOfOffice, membersSkipped, channelID, date)
+	case config.ReportFormatCustom:
+		post, err = generateCustomStandupReport(standupConfig, members, membersNoStandup, membersOutOfOffice, membersSkipped, channelID, date)
 	default:
 		err = errors.New("Unknown report format encountered for channel: " + channelID + ", report format: " + standupConfig.ReportFormat)
 		logger.Error("Unknown report format encountered for channel", err, nil)
@@ -258,44 +310,35 @@ func sortUserStandups(userStandups []*standup.UserStandup) ([]*standup.UserStand
 	return sortedUserStandups, nil
 }
 
-// SetNotificationStatus sets provided notification status for the specified channel ID.
-func SetNotificationStatus(channelID string, status *ChannelNotificationStatus) error {
-	standupConfig, err := standup.GetStandupConfig(channelID)
+// SetNotificationStatus sets provided notification status for the specified channel standup.
+func SetNotificationStatus(channelID, standupID string, status *ChannelNotificationStatus) error {
+	standupConfig, err := standup.GetStandupConfig(channelID, standupID)
 	if err != nil {
 		return err
 	}
 	if standupConfig == nil {
 		return errors.New("standup not configured for channel: " + channelID)
 	}
-	key := fmt.Sprintf("%s_%s_%s", config.CacheKeyPrefixNotificationStatus, channelID, util.GetCurrentDateString(standupConfig.Timezone))
-	serializedStatus, err := json.Marshal(status)
-	if err != nil {
-		logger.Error("Couldn't marshal standup status data", err, nil)
-		return err
-	}
-
-	if appErr := config.Mattermost.KVSet(util.GetKeyHash(key), serializedStatus); appErr != nil {
-		logger.Error("Couldn't save standup status data into KV store", appErr, nil)
-		return errors.New(appErr.Error())
-	}
 
-	return nil
+	return standup.GetStore().SetNotificationStatus(channelID, standupID, standupConfig.CurrentStandupDate().GetDateString(), status)
 }
 
-// filterChannelNotification filters all provided standup channels into three categories -
-// 1. channels requiring window open notification
-// 2. channels requiring window close notification
-// 3. channels requiring standup report
-func filterChannelNotification(channelIDs map[string]string) ([]string, []string, []string, error) {
+// filterChannelNotification filters all provided channel standups into three categories -
+// 1. standups requiring window open notification
+// 2. standups requiring window close notification
+// 3. standups requiring standup report
+// Channels are provided as a map of standup key to channel ID.
+func filterChannelNotification(channels map[string]string) ([]channelStandup, []channelStandup, []channelStandup, error) {
 	logger.Debug("Filtering channels for sending notifications", nil)
-	logger.Debug(fmt.Sprintf("Channels to process: %d", len(channelIDs)), nil, nil)
+	logger.Debug(fmt.Sprintf("Standups to process: %d", len(channels)), nil, nil)
 
-	var windowOpenNotificationChannels, windowCloseNotificationChannels, standupReportChannels []string
+	var windowOpenNotificationChannels, windowCloseNotificationChannels, standupReportChannels []channelStandup
 
-	for channelID := range channelIDs {
-		logger.Debug(fmt.Sprintf("Processing channel: %s", channelID), nil)
+	for standupKey, channelID := range channels {
+		standupID := standup.ParseStandupKey(standupKey, channelID)
+		logger.Debug(fmt.Sprintf("Processing channel: %s, standup: %s", channelID, standupID), nil)
 
-		standupConfig, err := standup.GetStandupConfig(channelID)
+		standupConfig, err := standup.GetStandupConfig(channelID, standupID)
 		if err != nil {
 			logger.Error("B", err, nil)
 			return nil, nil, nil, err
@@ -310,11 +353,16 @@ func filterChannelNotification(channelIDs map[string]string) ([]string, []string
 			continue
 		}
 
+		// handled separately as notifications are sent per member
+		if standupConfig.MemberTimezonesEnabled {
+			continue
+		}
+
 		if !isStandupDay(standupConfig) {
 			continue
 		}
 
-		notificationStatus, err := GetNotificationStatus(channelID)
+		notificationStatus, err := GetNotificationStatus(channelID, standupID)
 		if err != nil {
 			logger.Error("A", err, nil)
 			return nil, nil, nil, err
@@ -326,21 +374,19 @@ func filterChannelNotification(channelIDs map[string]string) ([]string, []string
 
 		if status := shouldSendStandupReport(notificationStatus, standupConfig); status == ChannelNotificationStatusSend {
 			logger.Debug(fmt.Sprintf("Channel [%s] needs standup report", channelID), nil)
-			standupReportChannels = append(standupReportChannels, channelID)
+			standupReportChannels = append(standupReportChannels, channelStandup{ChannelID: channelID, StandupID: standupID})
 		} else if status == ChannelNotificationStatusSent {
 			// pass
-		} else if shouldSendWindowCloseNotification(notificationStatus, standupConfig) == ChannelNotificationStatusSend {
-			if standupConfig.WindowCloseReminderEnabled {
-				logger.Debug(fmt.Sprintf("Channel [%s] needs window close notification", channelID), nil)
-				windowCloseNotificationChannels = append(windowCloseNotificationChannels, channelID)
-			}
-		} else if status == ChannelNotificationStatusSent {
+		} else if step, reminder := shouldSendReminder(notificationStatus, standupConfig); step < 0 {
 			// pass
-		} else if shouldSendWindowOpenNotification(notificationStatus, standupConfig) == ChannelNotificationStatusSend {
+		} else if reminder.IsWindowOpen() {
 			if standupConfig.WindowOpenReminderEnabled {
 				logger.Debug(fmt.Sprintf("Channel [%s] needs window open notification", channelID), nil)
-				windowOpenNotificationChannels = append(windowOpenNotificationChannels, channelID)
+				windowOpenNotificationChannels = append(windowOpenNotificationChannels, channelStandup{ChannelID: channelID, StandupID: standupID, ReminderStep: step})
 			}
+		} else if standupConfig.WindowCloseReminderEnabled {
+			logger.Debug(fmt.Sprintf("Channel [%s] needs window close notification", channelID), nil)
+			windowCloseNotificationChannels = append(windowCloseNotificationChannels, channelStandup{ChannelID: channelID, StandupID: standupID, ReminderStep: step})
 		}
 	}
 
@@ -353,94 +399,108 @@ func filterChannelNotification(channelIDs map[string]string) ([]string, []string
 	return windowOpenNotificationChannels, windowCloseNotificationChannels, standupReportChannels, nil
 }
 
-// shouldSendWindowOpenNotification checks if window open notification should
-// be sent to the channel with specified notification status
-func shouldSendWindowOpenNotification(notificationStatus *ChannelNotificationStatus, standupConfig *standup.Config) string {
-	if notificationStatus.WindowOpenNotificationSent {
-		return ChannelNotificationStatusSent
-	}
-
-	now := otime.Now(standupConfig.Timezone).GetTimeWithSeconds(standupConfig.Timezone)
-	next := standupConfig.WindowOpenTime.GetTimeWithSeconds(standupConfig.Timezone).Time
-
-	if now.After(next) {
-		return ChannelNotificationStatusSend
-	}
-
-	return ChannelNotificationStatusNotYet
+// shouldSendReminder returns the reminder to send to the channel with specified notification status
+// along with its index in standup's reminder schedule, or -1 if no reminder needs to be sent.
+func shouldSendReminder(notificationStatus *ChannelNotificationStatus, standupConfig *standup.Config) (int, standup.Reminder) {
+	now := otime.Now(standupConfig.Timezone).Time
+	windowOpen, windowClose := standupConfig.Window(standupConfig.StandupDate(now))
+	return dueReminder(standupConfig, windowOpen, windowClose, now, notificationStatus.RemindersSent)
 }
 
-// shouldSendWindowCloseNotification checks if window close notification should
-// be sent to the channel with specified notification status
-func shouldSendWindowCloseNotification(notificationStatus *ChannelNotificationStatus, standupConfig *standup.Config) string {
-	if notificationStatus.WindowCloseNotificationSent {
-		return ChannelNotificationStatusSent
+// dueReminder returns the latest reminder due at the specified time in the specified window
+// along with its index in standup's reminder schedule, or -1 if it has already been sent or no reminder is due.
+// Only the latest reminder is considered so expired reminders are not sent
+// in case some of the reminders were missed in the past.
+func dueReminder(standupConfig *standup.Config, windowOpen, windowClose, now time.Time, remindersSent []int) (int, standup.Reminder) {
+	step := -1
+	var reminder standup.Reminder
+	for i, r := range standupConfig.ReminderSchedule() {
+		if now.After(r.Time(windowOpen, windowClose)) {
+			step, reminder = i, r
+		}
 	}
 
-	windowDuration := standupConfig.WindowCloseTime.GetTime(standupConfig.Timezone).Time.Sub(standupConfig.WindowOpenTime.GetTime(standupConfig.Timezone).Time)
-	targetDurationSeconds := windowDuration.Seconds() * config.WindowCloseNotificationDurationPercentage
-	targetDuration, _ := time.ParseDuration(fmt.Sprintf("%fs", targetDurationSeconds))
-
-	// now we just need to check if current time is targetDuration seconds after window open time
-	if otime.Now(standupConfig.Timezone).GetTimeWithSeconds(standupConfig.Timezone).After(standupConfig.WindowOpenTime.GetTimeWithSeconds(standupConfig.Timezone).Add(targetDuration)) {
-		return ChannelNotificationStatusSend
+	if step < 0 || funk.ContainsInt(remindersSent, step) {
+		return -1, reminder
 	}
 
-	return ChannelNotificationStatusNotYet
+	return step, reminder
 }
 
 // shouldSendStandupReport checks if standup report should
 // be sent to the channel with specified notification status
 func shouldSendStandupReport(notificationStatus *ChannelNotificationStatus, standupConfig *standup.Config) string {
+	now := otime.Now(standupConfig.Timezone).Time
+	_, windowClose := standupConfig.Window(standupConfig.StandupDate(now))
+
 	if notificationStatus.StandupReportSent {
 		return ChannelNotificationStatusSent
-	} else if otime.Now(standupConfig.Timezone).GetTimeWithSeconds(standupConfig.Timezone).After(standupConfig.WindowCloseTime.GetTimeWithSeconds(standupConfig.Timezone).Time) {
+	} else if now.After(windowClose) {
 		return ChannelNotificationStatusSend
 	}
 
 	return ChannelNotificationStatusNotYet
 }
 
-// sendWindowOpenNotification sends window open notification to the specified channels
-func sendWindowOpenNotification(channelIDs []string) {
-	for _, channelID := range channelIDs {
-		post := &model.Post{
-			ChannelId: channelID,
-			UserId:    config.GetConfig().BotUserID,
-			Type:      model.POST_DEFAULT,
-			Message:   "Please start filling your standup!",
-		}
-
-		post, appErr := config.Mattermost.CreatePost(post)
-		if appErr != nil {
-			logger.Error("Error sending window open notification for channel", appErr, map[string]interface{}{"channelID": channelID})
+// sendWindowOpenNotification sends window open notification, asking everyone to
+// start filling their standup, to the specified channel standups
+func sendWindowOpenNotification(standups []channelStandup) {
+	for _, s := range standups {
+		channelID, standupID := s.ChannelID, s.StandupID
+		standupConfig, err := standup.GetStandupConfig(channelID, standupID)
+		if err != nil || standupConfig == nil {
+			logger.Error("Unable to find standup config for channel", err, map[string]interface{}{"channelID": channelID})
 			continue
 		}
 
-		err := addReminderPost(post.Id, channelID)
+		notificationStatus, err := GetNotificationStatus(channelID, standupID)
 		if err != nil {
-			logger.Error("Couldn't add standup reminder posts", err, nil)
 			continue
 		}
 
-		notificationStatus, err := GetNotificationStatus(channelID)
+		// members yet to fill their standup are only needed for messaging
+		// them directly or for rendering the reminder message
+		date := standupConfig.CurrentStandupDate()
+		var userIDs []string
+		if standupConfig.DirectMessageReminders || standupConfig.WindowOpenMessage != "" {
+			if userIDs, err = pendingMembers(standupConfig, date, notificationStatus); err != nil {
+				continue
+			}
+		}
+
+		message := "Please start filling your " + standupName(standupID) + "!"
+		customMessage := customReminderMessage(standupConfig, standupConfig.WindowOpenMessage, date, userIDs)
+		if standupConfig.DirectMessageReminders {
+			err = sendDirectMessageReminders(standupConfig, userIDs, message, customMessage)
+		} else {
+			if customMessage != "" {
+				message = customMessage
+			}
+
+			err = postReminder(standupConfig, message)
+		}
+
 		if err != nil {
+			logger.Error("Error sending window open notification for channel", err, map[string]interface{}{"channelID": channelID})
 			continue
 		}
 
-		notificationStatus.WindowOpenNotificationSent = true
-		if err := SetNotificationStatus(channelID, notificationStatus); err != nil {
+		notificationStatus.RemindersSent = append(notificationStatus.RemindersSent, s.ReminderStep)
+		if err := SetNotificationStatus(channelID, standupID, notificationStatus); err != nil {
 			continue
 		}
 	}
 }
 
-// sendWindowCloseNotification sends window close notification to the specified channels
-func sendWindowCloseNotification(channelIDs []string) error {
-	for _, channelID := range channelIDs {
-		standupConfig, err := standup.GetStandupConfig(channelID)
+// sendWindowCloseNotification sends reminder to members who are yet to fill
+// their standup to the specified channel standups
+func sendWindowCloseNotification(standups []channelStandup) error {
+	for _, s := range standups {
+		channelID, standupID := s.ChannelID, s.StandupID
+		standupConfig, err := standup.GetStandupConfig(channelID, standupID)
 		if err != nil {
-			return err
+			logger.Error("Couldn't fetch standup config for channel", err, map[string]interface{}{"channelID": channelID, "standupID": standupID})
+			continue
 		}
 
 		if standupConfig == nil {
@@ -448,63 +508,100 @@ func sendWindowCloseNotification(channelIDs []string) error {
 			continue
 		}
 
+		notificationStatus, err := GetNotificationStatus(channelID, standupID)
+		if err != nil {
+			continue
+		}
+
 		logger.Debug("Fetching members with pending standup reports", nil)
 
-		var usersPendingStandup []string
-		for _, userID := range standupConfig.Members {
-			userStandup, err := standup.GetUserStandup(userID, channelID, otime.Now(standupConfig.Timezone))
-			if err != nil {
-				return err
+		date := standupConfig.CurrentStandupDate()
+		userIDs, err := pendingMembers(standupConfig, date, notificationStatus)
+		if err != nil {
+			logger.Error("Couldn't fetch members with pending standup for channel", err, map[string]interface{}{"channelID": channelID, "standupID": standupID})
+			continue
+		}
+
+		// no need to send reminder if everyone has filled their standup,
+		// but the step is marked sent so it isn't checked again
+		if len(userIDs) == 0 {
+			logger.Debug("Not sending window close notification. No pending standups found.", nil, "channelID", channelID)
+			notificationStatus.RemindersSent = append(notificationStatus.RemindersSent, s.ReminderStep)
+			if err := SetNotificationStatus(channelID, standupID, notificationStatus); err != nil {
+				logger.Error("Couldn't save notification status for channel", err, map[string]interface{}{"channelID": channelID, "standupID": standupID})
 			}
+			continue
+		}
 
-			if userStandup == nil {
-				user, err := config.Mattermost.GetUser(userID)
-				if err != nil {
-					logger.Error("Couldn't find user with user ID", err, map[string]interface{}{"userID": userID})
-					return err
-				}
+		message := "a gentle reminder to fill your " + standupName(standupID) + "."
+		customMessage := customReminderMessage(standupConfig, standupConfig.WindowCloseMessage, date, userIDs)
+		if standupConfig.DirectMessageReminders {
+			if err := sendDirectMessageReminders(standupConfig, userIDs, message, customMessage); err != nil {
+				logger.Error("Error sending window close notification for channel", err, map[string]interface{}{"channelID": channelID})
+				continue
+			}
+		} else if customMessage != "" {
+			if err := postReminder(standupConfig, customMessage); err != nil {
+				logger.Error("Error sending window close notification for channel", err, map[string]interface{}{"channelID": channelID})
+				continue
+			}
+		} else {
+			usersPendingStandup, err := getUsernames(userIDs)
+			if err != nil {
+				logger.Error("Error sending window close notification for channel", err, map[string]interface{}{"channelID": channelID})
+				continue
+			}
 
-				usersPendingStandup = append(usersPendingStandup, user.Username)
+			if err := postReminder(standupConfig, fmt.Sprintf("@%s - %s", strings.Join(usersPendingStandup, ", @"), message)); err != nil {
+				logger.Error("Error sending window close notification for channel", err, map[string]interface{}{"channelID": channelID})
+				continue
 			}
 		}
 
-		// no need to send reminder if everyone has filled their standup
-		if len(usersPendingStandup) == 0 {
-			logger.Debug("Not sending window close notification. No pending standups found.", nil, nil)
-			return nil
+		notificationStatus.RemindersSent = append(notificationStatus.RemindersSent, s.ReminderStep)
+		if err := SetNotificationStatus(channelID, standupID, notificationStatus); err != nil {
+			return err
 		}
+	}
 
-		// if everyone didn't fill their standups, there are
-		// some users who are yet to fill it.
-		message := fmt.Sprintf("@%s - a gentle reminder to fill your standup.", strings.Join(usersPendingStandup, ", @"))
-		post := &model.Post{
-			ChannelId: channelID,
-			UserId:    config.GetConfig().BotUserID,
-			Type:      model.POST_DEFAULT,
-			Message:   message,
-		}
+	return nil
+}
 
-		post, appErr := config.Mattermost.CreatePost(post)
+// getUsernames returns usernames of the specified users in the same order.
+func getUsernames(userIDs []string) ([]string, error) {
+	usernames := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		user, appErr := config.Mattermost.GetUser(userID)
 		if appErr != nil {
-			logger.Error("Error sending window open notification for channel", appErr, map[string]interface{}{"channelID": channelID})
-			continue
+			logger.Error("Couldn't find user with user ID", appErr, map[string]interface{}{"userID": userID})
+			return nil, errors.New(appErr.Error())
 		}
 
-		err = addReminderPost(post.Id, channelID)
-		if err != nil {
-			logger.Error("Couldn't add standup reminder posts", err, nil)
-			return errors.New(err.Error())
-		}
+		usernames = append(usernames, user.Username)
+	}
 
-		notificationStatus, err := GetNotificationStatus(channelID)
-		if err != nil {
-			continue
-		}
+	return usernames, nil
+}
 
-		notificationStatus.WindowCloseNotificationSent = true
-		if err := SetNotificationStatus(channelID, notificationStatus); err != nil {
-			return err
-		}
+// postReminder posts the reminder message with reminder actions in standup channel.
+// The post is deleted once standup report is sent.
+func postReminder(standupConfig *standup.Config, message string) error {
+	post := &model.Post{
+		ChannelId: standupConfig.ChannelID,
+		UserId:    config.GetConfig().BotUserID,
+		Type:      model.POST_DEFAULT,
+		Message:   message,
+	}
+	attachReminderActions(post, standupConfig)
+
+	post, appErr := config.Mattermost.CreatePost(post)
+	if appErr != nil {
+		return errors.New(appErr.Error())
+	}
+
+	if err := addReminderPost(post.Id, standupConfig.ChannelID, standupConfig.StandupID); err != nil {
+		logger.Error("Couldn't add standup reminder posts", err, nil)
+		return err
 	}
 
 	return nil
@@ -515,6 +612,8 @@ func generateTypeAggregatedStandupReport(
 	standupConfig *standup.Config,
 	userStandups []*standup.UserStandup,
 	membersNoStandup []string,
+	membersOutOfOffice []string,
+	membersSkipped []string,
 	channelID string,
 	date otime.OTime,
 ) (*model.Post, error) {
@@ -541,7 +640,8 @@ func generateTypeAggregatedStandupReport(
 		}
 	}
 
-	text := fmt.Sprintf("#### Standup Report for *%s*\n\n", date.Format("2 Jan 2006"))
+	text := fmt.Sprintf("#### %s for *%s*\n\n", reportTitle(standupConfig.StandupID), date.Format("2 Jan 2006"))
+	text += absentMembersText(membersOutOfOffice, membersSkipped)
 
 	if len(userStandups) > 0 {
 		if len(membersNoStandup) > 0 {
@@ -575,6 +675,8 @@ func generateUserAggregatedStandupReport(
 	standupConfig *standup.Config,
 	userStandups []*standup.UserStandup,
 	membersNoStandup []string,
+	membersOutOfOffice []string,
+	membersSkipped []string,
 	channelID string,
 	date otime.OTime,
 ) (*model.Post, error) {
@@ -590,22 +692,13 @@ func generateUserAggregatedStandupReport(
 		}
 
 		header := fmt.Sprintf("#### %s %s", util.UserIcon(userStandup.UserID), userDisplayName)
-
-		userTask := header + "\n\n"
-
-		for _, sectionTitle := range standupConfig.Sections {
-			if userStandup.Standup[sectionTitle] == nil || len(*userStandup.Standup[sectionTitle]) == 0 {
-				continue
-			}
-
-			userTask += fmt.Sprintf("##### %s\n", sectionTitle)
-			userTask += "1. " + strings.Join(*userStandup.Standup[sectionTitle], "\n1. ") + "\n\n"
-		}
-
-		userTasks += userTask
+		userTasks += header + "\n\n" + userStandupText(standupConfig, userStandup)
 	}
 
-	text := fmt.Sprintf("#### Standup Report for *%s*\n", date.Format("2 Jan 2006"))
+	text := fmt.Sprintf("#### %s for *%s*\n", reportTitle(standupConfig.StandupID), date.Format("2 Jan 2006"))
+	if absentText := absentMembersText(membersOutOfOffice, membersSkipped); absentText != "" {
+		text += "\n" + absentText
+	}
 
 	if len(userStandups) > 0 {
 		if len(membersNoStandup) > 0 {
@@ -626,67 +719,153 @@ func generateUserAggregatedStandupReport(
 	}, nil
 }
 
-func getUserDisplayName(userID string) (string, error) {
-	user, appErr := config.Mattermost.GetUser(userID)
-	if appErr != nil {
-		return "", errors.New(appErr.Error())
+// generateCustomStandupReport generates a standup report by rendering the report template of standup.
+// A User Aggregated standup report is generated instead if the template fails to render.
+func generateCustomStandupReport(
+	standupConfig *standup.Config,
+	userStandups []*standup.UserStandup,
+	membersNoStandup []string,
+	membersOutOfOffice []string,
+	membersSkipped []string,
+	channelID string,
+	date otime.OTime,
+) (*model.Post, error) {
+	logger.Debug("Generating custom standup report for channel: "+channelID, nil)
+
+	report := &standup.Report{
+		Title:              reportTitle(standupConfig.StandupID),
+		Date:               date.Time,
+		Sections:           standupConfig.Sections,
+		MembersNoStandup:   membersNoStandup,
+		MembersOutOfOffice: membersOutOfOffice,
+		MembersSkipped:     membersSkipped,
+		Stats: standup.ReportStats{
+			MemberCount:      len(standupConfig.Members),
+			SubmissionCount:  len(userStandups),
+			NoStandupCount:   len(membersNoStandup),
+			OutOfOfficeCount: len(membersOutOfOffice),
+			SkippedCount:     len(membersSkipped),
+		},
 	}
-	return user.GetDisplayName(model.SHOW_FULLNAME), nil
-}
 
-func addReminderPost(postID string, channelID string) error {
-	reminderPosts, err := getReminderPosts(channelID)
+	for _, userStandup := range userStandups {
+		user, appErr := config.Mattermost.GetUser(userStandup.UserID)
+		if appErr != nil {
+			logger.Debug("Couldn't fetch user", appErr, map[string]string{"userID": userStandup.UserID})
+			return nil, errors.New(appErr.Error())
+		}
+
+		member := &standup.ReportMember{
+			UserID:      userStandup.UserID,
+			Username:    user.Username,
+			DisplayName: user.GetDisplayName(model.SHOW_FULLNAME),
+		}
+
+		for _, sectionTitle := range standupConfig.Sections {
+			section := &standup.ReportSection{Title: sectionTitle}
+			if userStandup.Standup[sectionTitle] != nil {
+				section.Tasks = *userStandup.Standup[sectionTitle]
+			}
+
+			report.Stats.TaskCount += len(section.Tasks)
+			member.Sections = append(member.Sections, section)
+		}
+
+		report.Members = append(report.Members, member)
+	}
+
+	text, err := standup.RenderReport(standupConfig.ReportTemplate, report)
 	if err != nil {
-		return err
+		logger.Error("Couldn't render standup report template, generating user aggregated report instead", err, map[string]interface{}{"channelID": channelID})
+		return generateUserAggregatedStandupReport(standupConfig, userStandups, membersNoStandup, membersOutOfOffice, membersSkipped, channelID, date)
 	}
 
-	reminderPosts = append(reminderPosts, postID)
-	if err := saveReminderPosts(reminderPosts, channelID); err != nil {
-		return err
+	return &model.Post{
+		ChannelId: channelID,
+		UserId:    config.GetConfig().BotUserID,
+		Message:   text,
+	}, nil
+}
+
+// userStandupText lists tasks of each standup section the user filled.
+func userStandupText(standupConfig *standup.Config, userStandup *standup.UserStandup) string {
+	text := ""
+	for _, sectionTitle := range standupConfig.Sections {
+		if userStandup.Standup[sectionTitle] == nil || len(*userStandup.Standup[sectionTitle]) == 0 {
+			continue
+		}
+
+		text += fmt.Sprintf("##### %s\n", sectionTitle)
+		text += "1. " + strings.Join(*userStandup.Standup[sectionTitle], "\n1. ") + "\n\n"
 	}
 
-	return nil
+	return text
 }
 
-func getReminderPosts(channelID string) ([]string, error) {
-	key := fmt.Sprintf("%s_%s", "reminderPosts", channelID)
-	reminderPostsJSON, appErr := config.Mattermost.KVGet(util.GetKeyHash(key))
-	if appErr != nil {
-		logger.Error("Couldn't get standup reminder posts from KV store", appErr, nil)
-		return nil, errors.New(appErr.Error())
+// absentMembersText lists members out of office and members who skipped their standup in standup report.
+func absentMembersText(membersOutOfOffice, membersSkipped []string) string {
+	text := ""
+	if len(membersOutOfOffice) > 0 {
+		text += fmt.Sprintf(":palm_tree: **Out of office:** %s\n", strings.Join(membersOutOfOffice, ", "))
 	}
 
-	if len(reminderPostsJSON) == 0 {
-		return []string{}, nil
+	if len(membersSkipped) > 0 {
+		text += fmt.Sprintf(":fast_forward: **Skipped:** %s\n", strings.Join(membersSkipped, ", "))
 	}
 
-	var reminderPosts []string
-	if err := json.Unmarshal(reminderPostsJSON, &reminderPosts); err != nil {
-		logger.Error("Couldn't unmarshal standup reminder posts", err, nil)
-		return nil, err
+	return text
+}
+
+// standupName is how the standup is referred to in reminder messages.
+func standupName(standupID string) string {
+	if standupID == standup.DefaultStandupID {
+		return "standup"
+	}
+
+	return "standup (" + standupID + ")"
+}
+
+// reportTitle is the title of the standup report, without the report date.
+func reportTitle(standupID string) string {
+	if standupID == standup.DefaultStandupID {
+		return "Standup Report"
 	}
 
-	return reminderPosts, nil
+	return "Standup Report (" + standupID + ")"
 }
 
-func saveReminderPosts(reminderPosts []string, channelID string) error {
-	serializedReminderPosts, err := json.Marshal(reminderPosts)
+func getUserDisplayName(userID string) (string, error) {
+	user, appErr := config.Mattermost.GetUser(userID)
+	if appErr != nil {
+		return "", errors.New(appErr.Error())
+	}
+	return user.GetDisplayName(model.SHOW_FULLNAME), nil
+}
+
+func addReminderPost(postID string, channelID, standupID string) error {
+	reminderPosts, err := getReminderPosts(channelID, standupID)
 	if err != nil {
-		logger.Error("Couldn't marshal standup reminder posts", err, nil)
 		return err
 	}
 
-	key := fmt.Sprintf("%s_%s", "reminderPosts", channelID)
-	if appErr := config.Mattermost.KVSet(util.GetKeyHash(key), serializedReminderPosts); appErr != nil {
-		logger.Error("Couldn't save standup reminder posts into KV store", appErr, nil)
-		return errors.New(appErr.Error())
+	reminderPosts = append(reminderPosts, postID)
+	if err := saveReminderPosts(reminderPosts, channelID, standupID); err != nil {
+		return err
 	}
 
 	return nil
 }
 
-func deleteReminderPosts(channelID string) error {
-	reminderPosts, err := getReminderPosts(channelID)
+func getReminderPosts(channelID, standupID string) ([]string, error) {
+	return standup.GetStore().GetReminderPosts(channelID, standupID)
+}
+
+func saveReminderPosts(reminderPosts []string, channelID, standupID string) error {
+	return standup.GetStore().SetReminderPosts(channelID, standupID, reminderPosts)
+}
+
+func deleteReminderPosts(channelID, standupID string) error {
+	reminderPosts, err := getReminderPosts(channelID, standupID)
 	if err != nil {
 		return err
 	}
@@ -698,23 +877,28 @@ func deleteReminderPosts(channelID string) error {
 		}
 	}
 
-	// deleting KV store entry storing reminder posts for current channel
-	key := fmt.Sprintf("%s_%s", "reminderPosts", channelID)
-	if appErr := config.Mattermost.KVDelete(util.GetKeyHash(key)); appErr != nil {
-		logger.Error("Couldn't delete standup reminder posts from KV store", appErr, nil)
-		return errors.New(appErr.Error())
-	}
-
-	return nil
+	// deleting store entry storing reminder posts for current channel
+	return standup.GetStore().DeleteReminderPosts(channelID, standupID)
 }
 
+// isStandupDay checks if standup occurs on the date of the standup current time belongs to.
 func isStandupDay(standupConfig *standup.Config) bool {
-	todayOtime := otime.Now(standupConfig.Timezone)
-	today := time.Date(todayOtime.Year(), todayOtime.Month(), todayOtime.Day(), 0, 0, 0, 0, todayOtime.Location())
+	return isStandupDate(standupConfig, standupConfig.CurrentStandupDate().Time)
+}
+
+// isStandupDate checks if standup occurs on the calendar date of the specified time,
+// irrespective of the time's location.
+func isStandupDate(standupConfig *standup.Config, date time.Time) bool {
+	location, err := time.LoadLocation(standupConfig.Timezone)
+	if err != nil {
+		location = date.Location()
+	}
+
+	today := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, location)
 
 	oneMinBeforeToday := today.Add(-1 * time.Minute)
 	oneMinAfterToday := today.Add(24 * time.Hour)
 
-	rruleDays := standupConfig.RRule.Between(oneMinBeforeToday, oneMinAfterToday, false)
+	rruleDays := standupConfig.ScheduleSet().Between(oneMinBeforeToday, oneMinAfterToday, false)
 	return len(rruleDays) > 0
 }
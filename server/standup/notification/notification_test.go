@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -52,6 +53,15 @@ func baseMock(mockAPI *plugintest.API) {
 	mockAPI.On("KVGet", util.GetKeyHash(fmt.Sprintf("%s_%s", "reminderPosts", "channel_3"))).Return(nil, nil)
 	mockAPI.On("KVSet", util.GetKeyHash(fmt.Sprintf("%s_%s", "reminderPosts", "channel_3")), mock.Anything).Return(nil)
 	mockAPI.On("KVDelete", util.GetKeyHash(fmt.Sprintf("%s_%s", "reminderPosts", "channel_3"))).Return(nil)
+	mockAPI.On("KVGet", util.GetKeyHash("last_processed_occurrence_channel_1")).Return(nil, nil)
+	mockAPI.On("KVSet", util.GetKeyHash("last_processed_occurrence_channel_1"), mock.Anything).Return(nil)
+	mockAPI.On("KVGet", util.GetKeyHash("last_processed_occurrence_channel_2")).Return(nil, nil)
+	mockAPI.On("KVSet", util.GetKeyHash("last_processed_occurrence_channel_2"), mock.Anything).Return(nil)
+	mockAPI.On("KVGet", util.GetKeyHash("last_processed_occurrence_channel_3")).Return(nil, nil)
+	mockAPI.On("KVSet", util.GetKeyHash("last_processed_occurrence_channel_3"), mock.Anything).Return(nil)
+	for _, userID := range []string{"user_1", "user_id_1", "user_id_2", "user_id_3", "user_id_4"} {
+		mockAPI.On("KVGet", util.GetKeyHash("out_of_office_"+userID)).Return(nil, nil)
+	}
 
 	monkey.Patch(logger.Debug, func(msg string, err error, keyValuePairs ...interface{}) {})
 	monkey.Patch(logger.Error, func(msg string, err error, extraData map[string]interface{}) {})
@@ -64,7 +74,6 @@ func baseMock(mockAPI *plugintest.API) {
 	}
 
 	config.SetConfig(mockConfig)
-	otime.DefaultLocation = location
 }
 
 func TearDown() {
@@ -93,29 +102,25 @@ func TestSendNotificationsAndReports(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(SendStandupReport, func(channelIDs []string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
+	monkey.Patch(SendStandupReport, func(channelIDs []string, standupID string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
 		return nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		switch {
 		case channelID == "channel_1":
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  false,
-				WindowCloseNotificationSent: false,
+				StandupReportSent: false,
 			}, nil
 		case channelID == "channel_2":
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: false,
+				RemindersSent:     []int{0},
+				StandupReportSent: false,
 			}, nil
 		case channelID == "channel_3":
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: true,
+				RemindersSent:     []int{0, 1},
+				StandupReportSent: false,
 			}, nil
 		}
 
@@ -129,7 +134,7 @@ func TestSendNotificationsAndReports(t *testing.T) {
 		return
 	}
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		switch {
 		case channelID == "channel_1":
 			windowOpenTime := otime.OTime{
@@ -201,7 +206,7 @@ func TestSendNotificationsAndReports(t *testing.T) {
 		}
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		if channelID == "channel_1" {
 			return nil
 		} else if channelID == "channel_2" {
@@ -214,7 +219,7 @@ func TestSendNotificationsAndReports(t *testing.T) {
 		return nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		if channelID == "channel_1" {
 			if userID == "user_id_1" || userID == "user_id_2" {
 				return &standup.UserStandup{}, nil
@@ -234,8 +239,8 @@ func TestSendNotificationsAndReports(t *testing.T) {
 
 	assert.Nil(t, SendNotificationsAndReports(), "no error should have been produced")
 	mockAPI.AssertNumberOfCalls(t, "CreatePost", 1)
-	mockAPI.AssertNumberOfCalls(t, "KVGet", 1)
-	mockAPI.AssertNumberOfCalls(t, "KVSet", 1)
+	mockAPI.AssertNumberOfCalls(t, "KVGet", 6)
+	mockAPI.AssertNumberOfCalls(t, "KVSet", 4)
 }
 
 func TestSendNotificationsAndReports_NoStandupChannels(t *testing.T) {
@@ -297,28 +302,24 @@ func TestSendNotificationsAndReports_SendStandupReport_Error(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(SendStandupReport, func(channelIDs []string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
+	monkey.Patch(SendStandupReport, func(channelIDs []string, standupID string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
 		return errors.New("")
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		if channelID == "channel_1" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  false,
-				WindowCloseNotificationSent: false,
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_2" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: false,
+				RemindersSent:     []int{0},
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_3" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: true,
+				RemindersSent:     []int{0, 1},
+				StandupReportSent: false,
 			}, nil
 		}
 
@@ -332,7 +333,7 @@ func TestSendNotificationsAndReports_SendStandupReport_Error(t *testing.T) {
 		return
 	}
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		if channelID == "channel_1" {
 			windowOpenTime := otime.OTime{
 				Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
@@ -405,7 +406,7 @@ func TestSendNotificationsAndReports_SendStandupReport_Error(t *testing.T) {
 		return nil, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		if channelID == "channel_1" {
 			return nil
 		} else if channelID == "channel_2" {
@@ -418,7 +419,7 @@ func TestSendNotificationsAndReports_SendStandupReport_Error(t *testing.T) {
 		return nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		if channelID == "channel_1" {
 			if userID == "user_id_1" || userID == "user_id_2" {
 				return &standup.UserStandup{}, nil
@@ -438,7 +439,7 @@ func TestSendNotificationsAndReports_SendStandupReport_Error(t *testing.T) {
 
 	assert.NotNil(t, SendNotificationsAndReports(), "no error should have been produced")
 	mockAPI.AssertNumberOfCalls(t, "CreatePost", 1)
-	mockAPI.AssertNumberOfCalls(t, "KVGet", 1)
+	mockAPI.AssertNumberOfCalls(t, "KVGet", 3)
 	mockAPI.AssertNumberOfCalls(t, "KVSet", 1)
 }
 
@@ -464,11 +465,11 @@ func TestSendNotificationsAndReports_GetNotificationStatus_NoData(t *testing.T)
 		}, nil
 	})
 
-	monkey.Patch(SendStandupReport, func(channelIDs []string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
+	monkey.Patch(SendStandupReport, func(channelIDs []string, standupID string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
 		return nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{}, nil
 	})
 
@@ -478,7 +479,7 @@ func TestSendNotificationsAndReports_GetNotificationStatus_NoData(t *testing.T)
 		return
 	}
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		if channelID == "channel_1" {
 			windowOpenTime := otime.OTime{
 				Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
@@ -551,7 +552,7 @@ func TestSendNotificationsAndReports_GetNotificationStatus_NoData(t *testing.T)
 		return nil, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		if channelID == "channel_1" {
 			return nil
 		} else if channelID == "channel_2" {
@@ -564,7 +565,7 @@ func TestSendNotificationsAndReports_GetNotificationStatus_NoData(t *testing.T)
 		return nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		if channelID == "channel_1" {
 			if userID == "user_id_1" || userID == "user_id_2" {
 				return &standup.UserStandup{}, nil
@@ -584,8 +585,8 @@ func TestSendNotificationsAndReports_GetNotificationStatus_NoData(t *testing.T)
 
 	assert.Nil(t, SendNotificationsAndReports(), "no error should have been produced")
 	mockAPI.AssertNumberOfCalls(t, "CreatePost", 1)
-	mockAPI.AssertNumberOfCalls(t, "KVGet", 1)
-	mockAPI.AssertNumberOfCalls(t, "KVSet", 1)
+	mockAPI.AssertNumberOfCalls(t, "KVGet", 6)
+	mockAPI.AssertNumberOfCalls(t, "KVSet", 4)
 }
 
 func TestSendNotificationsAndReports_GetUser_Error(t *testing.T) {
@@ -613,28 +614,24 @@ func TestSendNotificationsAndReports_GetUser_Error(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(SendStandupReport, func(channelIDs []string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
+	monkey.Patch(SendStandupReport, func(channelIDs []string, standupID string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
 		return nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		if channelID == "channel_1" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  false,
-				WindowCloseNotificationSent: false,
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_2" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: false,
+				RemindersSent:     []int{0},
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_3" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: true,
+				RemindersSent:     []int{0, 1},
+				StandupReportSent: false,
 			}, nil
 		}
 
@@ -648,7 +645,7 @@ func TestSendNotificationsAndReports_GetUser_Error(t *testing.T) {
 		return
 	}
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-55 * time.Minute),
 		}
@@ -672,7 +669,7 @@ func TestSendNotificationsAndReports_GetUser_Error(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		if channelID == "channel_1" {
 			return nil
 		} else if channelID == "channel_2" {
@@ -685,7 +682,7 @@ func TestSendNotificationsAndReports_GetUser_Error(t *testing.T) {
 		return nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		if channelID == "channel_1" {
 			if userID == "user_id_1" || userID == "user_id_2" {
 				return nil, nil
@@ -737,28 +734,24 @@ func TestSendNotificationsAndReports_GetStandupConfig_Error(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(SendStandupReport, func(channelIDs []string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
+	monkey.Patch(SendStandupReport, func(channelIDs []string, standupID string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
 		return nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		if channelID == "channel_1" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  false,
-				WindowCloseNotificationSent: false,
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_2" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: false,
+				RemindersSent:     []int{0},
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_3" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: true,
+				RemindersSent:     []int{0, 1},
+				StandupReportSent: false,
 			}, nil
 		}
 
@@ -766,11 +759,11 @@ func TestSendNotificationsAndReports_GetStandupConfig_Error(t *testing.T) {
 		return nil, nil
 	})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, errors.New("")
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		if channelID == "channel_1" {
 			return nil
 		} else if channelID == "channel_2" {
@@ -783,7 +776,7 @@ func TestSendNotificationsAndReports_GetStandupConfig_Error(t *testing.T) {
 		return nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		if channelID == "channel_1" {
 			if userID == "user_id_1" || userID == "user_id_2" {
 				return nil, nil
@@ -830,28 +823,24 @@ func TestSendNotificationsAndReports_GetStandupConfig_Nil(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(SendStandupReport, func(channelIDs []string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
+	monkey.Patch(SendStandupReport, func(channelIDs []string, standupID string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
 		return nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		if channelID == "channel_1" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  false,
-				WindowCloseNotificationSent: false,
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_2" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: false,
+				RemindersSent:     []int{0},
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_3" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: true,
+				RemindersSent:     []int{0, 1},
+				StandupReportSent: false,
 			}, nil
 		}
 
@@ -859,11 +848,11 @@ func TestSendNotificationsAndReports_GetStandupConfig_Nil(t *testing.T) {
 		return nil, nil
 	})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		if channelID == "channel_1" {
 			return nil
 		} else if channelID == "channel_2" {
@@ -876,7 +865,7 @@ func TestSendNotificationsAndReports_GetStandupConfig_Nil(t *testing.T) {
 		return nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		if channelID == "channel_1" {
 			if userID == "user_id_1" || userID == "user_id_2" {
 				return nil, nil
@@ -920,28 +909,25 @@ func TestSendNotificationsAndReports_WindowOpenNotificationSent_Sent(t *testing.
 		}, nil
 	})
 
-	monkey.Patch(SendStandupReport, func(channelIDs []string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
+	monkey.Patch(SendStandupReport, func(channelIDs []string, standupID string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
 		return nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		if channelID == "channel_1" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: false,
+				RemindersSent:     []int{0},
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_2" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: false,
+				RemindersSent:     []int{0},
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_3" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: true,
+				RemindersSent:     []int{0, 1},
+				StandupReportSent: false,
 			}, nil
 		}
 
@@ -955,7 +941,7 @@ func TestSendNotificationsAndReports_WindowOpenNotificationSent_Sent(t *testing.
 		return
 	}
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		if channelID == "channel_1" {
 			windowOpenTime := otime.OTime{
 				Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
@@ -1028,7 +1014,7 @@ func TestSendNotificationsAndReports_WindowOpenNotificationSent_Sent(t *testing.
 		return nil, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		if channelID == "channel_1" {
 			return nil
 		} else if channelID == "channel_2" {
@@ -1041,7 +1027,7 @@ func TestSendNotificationsAndReports_WindowOpenNotificationSent_Sent(t *testing.
 		return nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		if channelID == "channel_1" {
 			if userID == "user_id_1" || userID == "user_id_2" {
 				return &standup.UserStandup{}, nil
@@ -1084,7 +1070,7 @@ func TestSendNotificationsAndReports_NotWorkDay(t *testing.T) {
 		return
 	}
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -1112,7 +1098,7 @@ func TestSendNotificationsAndReports_NotWorkDay(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -1142,6 +1128,82 @@ func TestSendNotificationsAndReports_NotWorkDay(t *testing.T) {
 	mockAPI.AssertNumberOfCalls(t, "CreatePost", 0)
 }
 
+func TestSendNotificationsAndReports_SkippedDate(t *testing.T) {
+	defer TearDown()
+	mockAPI := setUp()
+	baseMock(mockAPI)
+
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	mockConfig := &config.Configuration{
+		Location: location,
+	}
+
+	monkey.Patch(standup.GetStandupChannels, func() (map[string]string, error) {
+		return map[string]string{
+			"channel_1": "channel_1",
+		}, nil
+	})
+
+	parsedRRule, err := util.ParseRRuleFromString("FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,TU,WE,TH,FR,SA,SU", time.Now().Add(-5*24*time.Hour))
+	if err != nil {
+		t.Fatal("Couldn't parse RRULE", err)
+		return
+	}
+
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
+		windowOpenTime := otime.OTime{
+			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
+		}
+		windowCloseTime := otime.OTime{
+			Time: otime.Now("Asia/Kolkata").Add(1 * time.Minute),
+		}
+
+		return &standup.Config{
+			ChannelID:                  "channel_1",
+			WindowOpenTime:             windowOpenTime,
+			WindowCloseTime:            windowCloseTime,
+			Enabled:                    true,
+			Members:                    []string{"user_id_1", "user_id_2"},
+			ReportFormat:               config.ReportFormatUserAggregated,
+			Sections:                   []string{"section 1", "section 2"},
+			Timezone:                   "Asia/Kolkata",
+			WindowOpenReminderEnabled:  true,
+			WindowCloseReminderEnabled: true,
+			RRuleString:                "FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,TU,WE,TH,FR,SA,SU",
+			RRule:                      parsedRRule,
+			ExDates:                    []string{otime.Now("Asia/Kolkata").Format(otime.LayoutISODate)},
+		}, nil
+	})
+
+	config.SetConfig(mockConfig)
+
+	assert.Nil(t, SendNotificationsAndReports(), "no error should have been produced")
+	mockAPI.AssertNumberOfCalls(t, "CreatePost", 0)
+}
+
+func TestIsStandupDay_ScheduleExceptions(t *testing.T) {
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	// every Monday, starting from Monday, 6 January 2020
+	parsedRRule, err := util.ParseRRuleFromString("FREQ=WEEKLY;INTERVAL=1;BYDAY=MO", time.Date(2020, 1, 6, 0, 0, 0, 0, location))
+	if err != nil {
+		t.Fatal("Couldn't parse RRULE", err)
+		return
+	}
+
+	standupConfig := &standup.Config{
+		Timezone: "Asia/Kolkata",
+		RRule:    parsedRRule,
+		ExDates:  []string{"2020-01-13"},
+		RDates:   []string{"2020-01-15"},
+	}
+
+	assert.True(t, isStandupDate(standupConfig, time.Date(2020, 1, 6, 10, 0, 0, 0, location)))
+	assert.False(t, isStandupDate(standupConfig, time.Date(2020, 1, 13, 10, 0, 0, 0, location)), "skipped date")
+	assert.True(t, isStandupDate(standupConfig, time.Date(2020, 1, 15, 10, 0, 0, 0, location)), "additional date")
+	assert.False(t, isStandupDate(standupConfig, time.Date(2020, 1, 16, 10, 0, 0, 0, location)))
+	assert.True(t, isStandupDate(standupConfig, time.Date(2020, 1, 20, 10, 0, 0, 0, location)))
+}
+
 func TestSendNotificationsAndReports_Integration(t *testing.T) {
 	defer TearDown()
 	mockAPI := setUp()
@@ -1150,7 +1212,7 @@ func TestSendNotificationsAndReports_Integration(t *testing.T) {
 	mockAPI.On("CreatePost", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{}, nil)
 	mockAPI.On("GetUser", mock.AnythingOfType("string")).Return(&model.User{Username: "username"}, nil)
 	mockAPI.On("KVGet", "uScyewRiWEwQavauYw9iOK76jISl+5Qq0mV+Cn/jFPs=").Return(
-		[]byte("{\"channel_1\": \":channel_1\"}"), nil,
+		[]byte("{\"channel_1\": \"channel_1\"}"), nil,
 	)
 	mockAPI.On("KVGet", util.GetKeyHash(fmt.Sprintf("%s_%s_%s", config.CacheKeyPrefixNotificationStatus, "channel_1", util.GetCurrentDateString("Asia/Kolkata")))).Return(nil, nil)
 
@@ -1213,15 +1275,13 @@ func TestSendNotificationsAndReports_sendWindowCloseNotification_Error(t *testin
 		}, nil
 	})
 
-	monkey.Patch(SendStandupReport, func(channelIDs []string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
+	monkey.Patch(SendStandupReport, func(channelIDs []string, standupID string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
 		return nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{
-			StandupReportSent:           false,
-			WindowOpenNotificationSent:  false,
-			WindowCloseNotificationSent: false,
+			StandupReportSent: false,
 		}, nil
 	})
 
@@ -1231,7 +1291,7 @@ func TestSendNotificationsAndReports_sendWindowCloseNotification_Error(t *testin
 		return
 	}
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -1255,11 +1315,11 @@ func TestSendNotificationsAndReports_sendWindowCloseNotification_Error(t *testin
 		}, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		return nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return &standup.UserStandup{}, nil
 	})
 
@@ -1287,19 +1347,17 @@ func TestSendNotificationsAndReports_FilterChannelNotifications_Error(t *testing
 		}, nil
 	})
 
-	monkey.Patch(SendStandupReport, func(channelIDs []string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
+	monkey.Patch(SendStandupReport, func(channelIDs []string, standupID string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
 		return nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{
-			StandupReportSent:           false,
-			WindowOpenNotificationSent:  false,
-			WindowCloseNotificationSent: false,
+			StandupReportSent: false,
 		}, nil
 	})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -1323,15 +1381,15 @@ func TestSendNotificationsAndReports_FilterChannelNotifications_Error(t *testing
 		}, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		return nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return &standup.UserStandup{}, nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return nil, errors.New("")
 	})
 
@@ -1361,15 +1419,13 @@ func TestSendNotificationsAndReports_Standup_Disabled(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{
-			StandupReportSent:           false,
-			WindowOpenNotificationSent:  false,
-			WindowCloseNotificationSent: false,
+			StandupReportSent: false,
 		}, nil
 	})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -1414,19 +1470,18 @@ func TestSendNotificationsAndReports_StandupReport_Sent(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(SendStandupReport, func(channelIDs []string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
+	monkey.Patch(SendStandupReport, func(channelIDs []string, standupID string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
 		return nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{
-			StandupReportSent:           true,
-			WindowOpenNotificationSent:  true,
-			WindowCloseNotificationSent: true,
+			RemindersSent:     []int{0, 1},
+			StandupReportSent: true,
 		}, nil
 	})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -1473,19 +1528,17 @@ func TestSendNotificationsAndReports_SendWindowOpenNotification_CreatePost_Error
 		}, nil
 	})
 
-	monkey.Patch(SendStandupReport, func(channelIDs []string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
+	monkey.Patch(SendStandupReport, func(channelIDs []string, standupID string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
 		return nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{
-			StandupReportSent:           false,
-			WindowOpenNotificationSent:  false,
-			WindowCloseNotificationSent: false,
+			StandupReportSent: false,
 		}, nil
 	})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -1509,11 +1562,11 @@ func TestSendNotificationsAndReports_SendWindowOpenNotification_CreatePost_Error
 		}, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		return nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return &standup.UserStandup{}, nil
 	})
 
@@ -1539,15 +1592,13 @@ func TestSendNotificationsAndReports_ShouldSendWindowOpenNotification_NotYet(t *
 		}, nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{
-			StandupReportSent:           false,
-			WindowOpenNotificationSent:  false,
-			WindowCloseNotificationSent: false,
+			StandupReportSent: false,
 		}, nil
 	})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(1 * time.Hour),
 		}
@@ -1595,19 +1646,17 @@ func TestSendNotificationsAndReports_WindowCloseNotification(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(SendStandupReport, func(channelIDs []string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
+	monkey.Patch(SendStandupReport, func(channelIDs []string, standupID string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
 		return nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{
-			StandupReportSent:           false,
-			WindowOpenNotificationSent:  false,
-			WindowCloseNotificationSent: false,
+			StandupReportSent: false,
 		}, nil
 	})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-55 * time.Minute),
 		}
@@ -1631,7 +1680,7 @@ func TestSendNotificationsAndReports_WindowCloseNotification(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		if channelID == "channel_1" {
 			return nil
 		} else if channelID == "channel_2" {
@@ -1644,14 +1693,14 @@ func TestSendNotificationsAndReports_WindowCloseNotification(t *testing.T) {
 		return nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return nil, nil
 	})
 
 	assert.Nil(t, SendNotificationsAndReports(), "no error should have been produced")
 	mockAPI.AssertNumberOfCalls(t, "CreatePost", 1)
-	mockAPI.AssertNumberOfCalls(t, "KVGet", 1)
-	mockAPI.AssertNumberOfCalls(t, "KVSet", 1)
+	mockAPI.AssertNumberOfCalls(t, "KVGet", 4)
+	mockAPI.AssertNumberOfCalls(t, "KVSet", 2)
 	mockAPI.AssertNumberOfCalls(t, "KVDelete", 0)
 }
 
@@ -1661,9 +1710,8 @@ func TestGetNotificationStatus(t *testing.T) {
 	baseMock(mockAPI)
 
 	notificationStatusJSON, _ := json.Marshal(ChannelNotificationStatus{
-		WindowOpenNotificationSent:  true,
-		WindowCloseNotificationSent: false,
-		StandupReportSent:           true,
+		RemindersSent:     []int{0},
+		StandupReportSent: true,
 	})
 	mockAPI.On("KVGet", mock.AnythingOfType("string")).Return(notificationStatusJSON, nil)
 
@@ -1674,13 +1722,12 @@ func TestGetNotificationStatus(t *testing.T) {
 
 	config.SetConfig(mockConfig)
 
-	actualNotificationStatus, err := GetNotificationStatus("channel_1")
+	actualNotificationStatus, err := GetNotificationStatus("channel_1", "")
 	assert.Nil(t, err, "no error should have been produced")
 
 	expectedNotificationStatus := &ChannelNotificationStatus{
-		WindowOpenNotificationSent:  true,
-		WindowCloseNotificationSent: false,
-		StandupReportSent:           true,
+		RemindersSent:     []int{0},
+		StandupReportSent: true,
 	}
 	assert.Equal(t, expectedNotificationStatus, actualNotificationStatus)
 }
@@ -1699,7 +1746,7 @@ func TestGetNotificationStatus_KVGet_Error(t *testing.T) {
 
 	config.SetConfig(mockConfig)
 
-	actualNotificationStatus, err := GetNotificationStatus("channel_1")
+	actualNotificationStatus, err := GetNotificationStatus("channel_1", "")
 	assert.NotNil(t, err, "error should have been produced as KVGet failed")
 	assert.Nil(t, actualNotificationStatus)
 }
@@ -1710,9 +1757,8 @@ func TestGetNotificationStatus_Json_Error(t *testing.T) {
 	baseMock(mockAPI)
 
 	notificationStatusJSON, _ := json.Marshal(ChannelNotificationStatus{
-		WindowOpenNotificationSent:  true,
-		WindowCloseNotificationSent: false,
-		StandupReportSent:           true,
+		RemindersSent:     []int{0},
+		StandupReportSent: true,
 	})
 	mockAPI.On("KVGet", mock.AnythingOfType("string")).Return(notificationStatusJSON[0:len(notificationStatusJSON)-10], nil)
 
@@ -1723,7 +1769,7 @@ func TestGetNotificationStatus_Json_Error(t *testing.T) {
 
 	config.SetConfig(mockConfig)
 
-	actualNotificationStatus, err := GetNotificationStatus("channel_1")
+	actualNotificationStatus, err := GetNotificationStatus("channel_1", "")
 	assert.NotNil(t, err, "error should have been produced as inbalid JSOn was returned by KVGet")
 	assert.Nil(t, actualNotificationStatus)
 }
@@ -1734,9 +1780,8 @@ func TestGetNotificationStatus_KVSet_Error(t *testing.T) {
 	baseMock(mockAPI)
 
 	notificationStatusJSON, _ := json.Marshal(ChannelNotificationStatus{
-		WindowOpenNotificationSent:  true,
-		WindowCloseNotificationSent: false,
-		StandupReportSent:           true,
+		RemindersSent:     []int{0},
+		StandupReportSent: true,
 	})
 	mockAPI.On("KVGet", mock.AnythingOfType("string")).Return(notificationStatusJSON, nil)
 	mockAPI.On("KVSet")
@@ -1748,13 +1793,12 @@ func TestGetNotificationStatus_KVSet_Error(t *testing.T) {
 
 	config.SetConfig(mockConfig)
 
-	actualNotificationStatus, err := GetNotificationStatus("channel_1")
+	actualNotificationStatus, err := GetNotificationStatus("channel_1", "")
 	assert.Nil(t, err, "no error should have been produced")
 
 	expectedNotificationStatus := &ChannelNotificationStatus{
-		WindowOpenNotificationSent:  true,
-		WindowCloseNotificationSent: false,
-		StandupReportSent:           true,
+		RemindersSent:     []int{0},
+		StandupReportSent: true,
 	}
 	assert.Equal(t, expectedNotificationStatus, actualNotificationStatus)
 }
@@ -1779,7 +1823,7 @@ func TestSendStandupReport(t *testing.T) {
 	)
 
 	mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.Anything).Return(&model.Post{})
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -1800,7 +1844,7 @@ func TestSendStandupReport(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return &standup.UserStandup{
 			UserID:    userID,
 			ChannelID: channelID,
@@ -1811,37 +1855,37 @@ func TestSendStandupReport(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{}, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		return nil
 	})
 
-	err := SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err := SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.Nil(t, err, "should not produce any error")
 
 	// no standup channels specified
-	err = SendStandupReport([]string{}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err = SendStandupReport([]string{}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.Nil(t, err, "should not produce any error")
 
 	// error in GetStandupConfig
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, errors.New("")
 	})
-	err = SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err = SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.NotNil(t, err, "should produce any error as GetStandupConfig failed")
 
 	// no standup config
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, nil
 	})
-	err = SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err = SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.NotNil(t, err, "should produce any error as GetStandupConfig didn't return any standup config")
 
 	// standup with no members
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -1862,9 +1906,9 @@ func TestSendStandupReport(t *testing.T) {
 			WindowCloseReminderEnabled: true,
 		}, nil
 	})
-	err = SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err = SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.Nil(t, err, "shouldn't produce error as standup with no members is a valid case")
-	mockAPI.AssertNumberOfCalls(t, "KVGet", 4)
+	mockAPI.AssertNumberOfCalls(t, "KVGet", 8)
 	mockAPI.AssertNumberOfCalls(t, "KVSet", 0)
 	mockAPI.AssertNumberOfCalls(t, "KVDelete", 4)
 }
@@ -1890,7 +1934,7 @@ func TestSendStandupReport_GetReminderPosts_Error(t *testing.T) {
 	)
 
 	mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.Anything).Return(&model.Post{})
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -1911,7 +1955,7 @@ func TestSendStandupReport_GetReminderPosts_Error(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return &standup.UserStandup{
 			UserID:    userID,
 			ChannelID: channelID,
@@ -1922,17 +1966,17 @@ func TestSendStandupReport_GetReminderPosts_Error(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{}, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		return nil
 	})
 
-	err := SendStandupReport([]string{"channel_1"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err := SendStandupReport([]string{"channel_1"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.Nil(t, err, "should not produce any error")
-	mockAPI.AssertNumberOfCalls(t, "KVGet", 1)
+	mockAPI.AssertNumberOfCalls(t, "KVGet", 3)
 	mockAPI.AssertNumberOfCalls(t, "KVSet", 0)
 	mockAPI.AssertNumberOfCalls(t, "KVDelete", 0)
 }
@@ -1958,7 +2002,7 @@ func TestSendStandupReport_DeleteReminderPosts_Error(t *testing.T) {
 	)
 
 	mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.Anything).Return(&model.Post{})
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -1979,7 +2023,7 @@ func TestSendStandupReport_DeleteReminderPosts_Error(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return &standup.UserStandup{
 			UserID:    userID,
 			ChannelID: channelID,
@@ -1990,17 +2034,17 @@ func TestSendStandupReport_DeleteReminderPosts_Error(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{}, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		return nil
 	})
 
-	err := SendStandupReport([]string{"channel_1"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err := SendStandupReport([]string{"channel_1"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.Nil(t, err, "should not produce any error")
-	mockAPI.AssertNumberOfCalls(t, "KVGet", 1)
+	mockAPI.AssertNumberOfCalls(t, "KVGet", 3)
 	mockAPI.AssertNumberOfCalls(t, "KVSet", 0)
 	mockAPI.AssertNumberOfCalls(t, "KVDelete", 1)
 }
@@ -2029,7 +2073,7 @@ func TestSendStandupReport_GetReminderPosts_DeletePostError(t *testing.T) {
 	)
 
 	mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.Anything).Return(&model.Post{})
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -2050,7 +2094,7 @@ func TestSendStandupReport_GetReminderPosts_DeletePostError(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return &standup.UserStandup{
 			UserID:    userID,
 			ChannelID: channelID,
@@ -2061,17 +2105,17 @@ func TestSendStandupReport_GetReminderPosts_DeletePostError(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{}, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		return nil
 	})
 
-	err := SendStandupReport([]string{"channel_1"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err := SendStandupReport([]string{"channel_1"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.Nil(t, err, "should not produce any error")
-	mockAPI.AssertNumberOfCalls(t, "KVGet", 1)
+	mockAPI.AssertNumberOfCalls(t, "KVGet", 3)
 	mockAPI.AssertNumberOfCalls(t, "KVSet", 0)
 	mockAPI.AssertNumberOfCalls(t, "KVDelete", 1)
 }
@@ -2100,7 +2144,7 @@ func TestSendStandupReport_GetReminderPosts_KVDeleteError(t *testing.T) {
 	)
 
 	mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.Anything).Return(&model.Post{})
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -2121,7 +2165,7 @@ func TestSendStandupReport_GetReminderPosts_KVDeleteError(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return &standup.UserStandup{
 			UserID:    userID,
 			ChannelID: channelID,
@@ -2132,17 +2176,17 @@ func TestSendStandupReport_GetReminderPosts_KVDeleteError(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{}, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		return nil
 	})
 
-	err := SendStandupReport([]string{"channel_1"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err := SendStandupReport([]string{"channel_1"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.Nil(t, err, "should not produce any error")
-	mockAPI.AssertNumberOfCalls(t, "KVGet", 1)
+	mockAPI.AssertNumberOfCalls(t, "KVGet", 3)
 	mockAPI.AssertNumberOfCalls(t, "KVSet", 0)
 	mockAPI.AssertNumberOfCalls(t, "KVDelete", 1)
 }
@@ -2168,7 +2212,7 @@ func TestSendStandupReport_GetReminderPosts_JsonError(t *testing.T) {
 	)
 
 	mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.Anything).Return(&model.Post{})
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -2189,7 +2233,7 @@ func TestSendStandupReport_GetReminderPosts_JsonError(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return &standup.UserStandup{
 			UserID:    userID,
 			ChannelID: channelID,
@@ -2200,17 +2244,17 @@ func TestSendStandupReport_GetReminderPosts_JsonError(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{}, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		return nil
 	})
 
-	err := SendStandupReport([]string{"channel_1"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err := SendStandupReport([]string{"channel_1"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.Nil(t, err, "should not produce any error")
-	mockAPI.AssertNumberOfCalls(t, "KVGet", 1)
+	mockAPI.AssertNumberOfCalls(t, "KVGet", 3)
 	mockAPI.AssertNumberOfCalls(t, "KVSet", 0)
 	mockAPI.AssertNumberOfCalls(t, "KVDelete", 0)
 }
@@ -2238,7 +2282,7 @@ func TestSendStandupReport_GetReminderPosts_Data(t *testing.T) {
 	)
 
 	mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.Anything).Return(&model.Post{})
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -2259,7 +2303,7 @@ func TestSendStandupReport_GetReminderPosts_Data(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return &standup.UserStandup{
 			UserID:    userID,
 			ChannelID: channelID,
@@ -2270,17 +2314,17 @@ func TestSendStandupReport_GetReminderPosts_Data(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{}, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		return nil
 	})
 
-	err := SendStandupReport([]string{"channel_1"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err := SendStandupReport([]string{"channel_1"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.Nil(t, err, "should not produce any error")
-	mockAPI.AssertNumberOfCalls(t, "KVGet", 1)
+	mockAPI.AssertNumberOfCalls(t, "KVGet", 3)
 	mockAPI.AssertNumberOfCalls(t, "KVSet", 0)
 	mockAPI.AssertNumberOfCalls(t, "KVDelete", 1)
 }
@@ -2290,7 +2334,7 @@ func TestSendStandupReport_GetUserStandup_Error(t *testing.T) {
 	mockAPI := setUp()
 	baseMock(mockAPI)
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -2312,11 +2356,11 @@ func TestSendStandupReport_GetUserStandup_Error(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return nil, errors.New("")
 	})
 
-	err := SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err := SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.NotNil(t, err, "should produce any error as GetUserStandup failed")
 }
 
@@ -2342,7 +2386,7 @@ func TestSendStandupReport_GetUserStandup_Nil(t *testing.T) {
 
 	mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.Anything).Return(&model.Post{})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -2364,41 +2408,45 @@ func TestSendStandupReport_GetUserStandup_Nil(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return nil, nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{}, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		return nil
 	})
 
-	err := SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	monkey.Patch(getNotificationStatusForDate, func(channelID, standupID, date string) (*ChannelNotificationStatus, error) {
+		return &ChannelNotificationStatus{}, nil
+	})
+
+	err := SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.Nil(t, err, "should not produce any error")
 
 	// no standup channels specified
-	err = SendStandupReport([]string{}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err = SendStandupReport([]string{}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.Nil(t, err, "should not produce any error")
 
 	// error in GetStandupConfig
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, errors.New("")
 	})
-	err = SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err = SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.NotNil(t, err, "should produce any error as GetStandupConfig failed")
 
 	// no standup config
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, nil
 	})
-	err = SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err = SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.NotNil(t, err, "should produce any error as GetStandupConfig didn't return any standup config")
 
 	// standup with no members
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -2419,9 +2467,9 @@ func TestSendStandupReport_GetUserStandup_Nil(t *testing.T) {
 			WindowCloseReminderEnabled: true,
 		}, nil
 	})
-	err = SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err = SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.Nil(t, err, "shouldn't produce error as standup with no members is a valid case")
-	mockAPI.AssertNumberOfCalls(t, "KVGet", 4)
+	mockAPI.AssertNumberOfCalls(t, "KVGet", 8)
 	mockAPI.AssertNumberOfCalls(t, "KVSet", 0)
 	mockAPI.AssertNumberOfCalls(t, "KVDelete", 4)
 }
@@ -2446,7 +2494,7 @@ func TestSendStandupReport_GetUserStandup_Nil_GetUser_Error(t *testing.T) {
 
 	mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.Anything).Return(&model.Post{})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -2468,11 +2516,15 @@ func TestSendStandupReport_GetUserStandup_Nil_GetUser_Error(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return nil, nil
 	})
 
-	err := SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	monkey.Patch(getNotificationStatusForDate, func(channelID, standupID, date string) (*ChannelNotificationStatus, error) {
+		return &ChannelNotificationStatus{}, nil
+	})
+
+	err := SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.NotNil(t, err, "should produce any error as GetUser failed")
 }
 
@@ -2497,7 +2549,7 @@ func TestSendStandupReport_ReportFormatUserAggregated(t *testing.T) {
 
 	mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.Anything).Return(&model.Post{})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -2519,7 +2571,7 @@ func TestSendStandupReport_ReportFormatUserAggregated(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return &standup.UserStandup{
 			UserID:    userID,
 			ChannelID: channelID,
@@ -2530,37 +2582,37 @@ func TestSendStandupReport_ReportFormatUserAggregated(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{}, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		return nil
 	})
 
-	err := SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err := SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.Nil(t, err, "should not produce any error")
 
 	// no standup channels specified
-	err = SendStandupReport([]string{}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err = SendStandupReport([]string{}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.Nil(t, err, "should not produce any error")
 
 	// error in GetStandupConfig
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, errors.New("")
 	})
-	err = SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err = SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.NotNil(t, err, "should produce any error as GetStandupConfig failed")
 
 	// no standup config
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, nil
 	})
-	err = SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err = SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.NotNil(t, err, "should produce any error as GetStandupConfig didn't return any standup config")
 
 	// standup with no members
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -2581,9 +2633,9 @@ func TestSendStandupReport_ReportFormatUserAggregated(t *testing.T) {
 			WindowCloseReminderEnabled: true,
 		}, nil
 	})
-	err = SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err = SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.Nil(t, err, "shouldn't produce error as standup with no members is a valid case")
-	mockAPI.AssertNumberOfCalls(t, "KVGet", 4)
+	mockAPI.AssertNumberOfCalls(t, "KVGet", 8)
 	mockAPI.AssertNumberOfCalls(t, "KVSet", 0)
 	mockAPI.AssertNumberOfCalls(t, "KVDelete", 4)
 }
@@ -2609,7 +2661,7 @@ func TestSendStandupReport_UnknownReportFormat(t *testing.T) {
 
 	mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.Anything).Return(&model.Post{})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -2631,7 +2683,7 @@ func TestSendStandupReport_UnknownReportFormat(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return &standup.UserStandup{
 			UserID:    userID,
 			ChannelID: channelID,
@@ -2642,7 +2694,7 @@ func TestSendStandupReport_UnknownReportFormat(t *testing.T) {
 		}, nil
 	})
 
-	err := SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err := SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.NotNil(t, err, "should produce error as report format was unknown")
 }
 
@@ -2667,7 +2719,7 @@ func TestSendStandupReport_ReportVisibility_Public(t *testing.T) {
 
 	mockAPI.On("CreatePost", mock.AnythingOfType("*model.Post"), mock.Anything).Return(&model.Post{}, nil)
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -2689,7 +2741,7 @@ func TestSendStandupReport_ReportVisibility_Public(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return &standup.UserStandup{
 			UserID:    userID,
 			ChannelID: channelID,
@@ -2700,37 +2752,37 @@ func TestSendStandupReport_ReportVisibility_Public(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{}, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		return nil
 	})
 
-	err := SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
+	err := SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
 	assert.Nil(t, err, "should not produce any error")
 
 	// no standup channels specified
-	err = SendStandupReport([]string{}, otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
+	err = SendStandupReport([]string{}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
 	assert.Nil(t, err, "should not produce any error")
 
 	// error in GetStandupConfig
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, errors.New("")
 	})
-	err = SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
+	err = SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
 	assert.NotNil(t, err, "should produce any error as GetStandupConfig failed")
 
 	// no standup config
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, nil
 	})
-	err = SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
+	err = SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
 	assert.NotNil(t, err, "should produce any error as GetStandupConfig didn't return any standup config")
 
 	// standup with no members
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -2751,9 +2803,9 @@ func TestSendStandupReport_ReportVisibility_Public(t *testing.T) {
 			WindowCloseReminderEnabled: true,
 		}, nil
 	})
-	err = SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
+	err = SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
 	assert.Nil(t, err, "shouldn't produce error as standup with no members is a valid case")
-	mockAPI.AssertNumberOfCalls(t, "KVGet", 4)
+	mockAPI.AssertNumberOfCalls(t, "KVGet", 8)
 	mockAPI.AssertNumberOfCalls(t, "KVSet", 0)
 	mockAPI.AssertNumberOfCalls(t, "KVDelete", 4)
 }
@@ -2779,7 +2831,7 @@ func TestSendStandupReport_ReportVisibility_Public_CreatePost_Error(t *testing.T
 
 	mockAPI.On("CreatePost", mock.AnythingOfType("*model.Post"), mock.Anything).Return(nil, model.NewAppError("", "", nil, "", 0))
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -2801,7 +2853,7 @@ func TestSendStandupReport_ReportVisibility_Public_CreatePost_Error(t *testing.T
 		}, nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return &standup.UserStandup{
 			UserID:    userID,
 			ChannelID: channelID,
@@ -2812,37 +2864,37 @@ func TestSendStandupReport_ReportVisibility_Public_CreatePost_Error(t *testing.T
 		}, nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{}, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		return nil
 	})
 
-	err := SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
+	err := SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
 	assert.NotNil(t, err, "should not produce any error")
 
 	// no standup channels specified
-	err = SendStandupReport([]string{}, otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
+	err = SendStandupReport([]string{}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
 	assert.Nil(t, err, "should not produce any error")
 
 	// error in GetStandupConfig
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, errors.New("")
 	})
-	err = SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
+	err = SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
 	assert.NotNil(t, err, "should produce any error as GetStandupConfig failed")
 
 	// no standup config
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, nil
 	})
-	err = SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
+	err = SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
 	assert.NotNil(t, err, "should produce any error as GetStandupConfig didn't return any standup config")
 
 	// standup with no members
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -2863,7 +2915,7 @@ func TestSendStandupReport_ReportVisibility_Public_CreatePost_Error(t *testing.T
 			WindowCloseReminderEnabled: true,
 		}, nil
 	})
-	err = SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
+	err = SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", false)
 	assert.NotNil(t, err, "shouldn't produce error as standup with no members is a valid case")
 }
 
@@ -2888,7 +2940,7 @@ func TestSendStandupReport_UpdateStatus_True(t *testing.T) {
 
 	mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.Anything).Return(&model.Post{})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -2910,7 +2962,7 @@ func TestSendStandupReport_UpdateStatus_True(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return &standup.UserStandup{
 			UserID:    userID,
 			ChannelID: channelID,
@@ -2921,37 +2973,37 @@ func TestSendStandupReport_UpdateStatus_True(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{}, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		return nil
 	})
 
-	err := SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", true)
+	err := SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", true)
 	assert.Nil(t, err, "should not produce any error")
 
 	// no standup channels specified
-	err = SendStandupReport([]string{}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
+	err = SendStandupReport([]string{}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", false)
 	assert.Nil(t, err, "should not produce any error")
 
 	// error in GetStandupConfig
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, errors.New("")
 	})
-	err = SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", true)
+	err = SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", true)
 	assert.NotNil(t, err, "should produce any error as GetStandupConfig failed")
 
 	// no standup config
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, nil
 	})
-	err = SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", true)
+	err = SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", true)
 	assert.NotNil(t, err, "should produce any error as GetStandupConfig didn't return any standup config")
 
 	// standup with no members
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -2972,9 +3024,9 @@ func TestSendStandupReport_UpdateStatus_True(t *testing.T) {
 			WindowCloseReminderEnabled: true,
 		}, nil
 	})
-	err = SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", true)
+	err = SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", true)
 	assert.Nil(t, err, "shouldn't produce error as standup with no members is a valid case")
-	mockAPI.AssertNumberOfCalls(t, "KVGet", 4)
+	mockAPI.AssertNumberOfCalls(t, "KVGet", 8)
 	mockAPI.AssertNumberOfCalls(t, "KVSet", 0)
 	mockAPI.AssertNumberOfCalls(t, "KVDelete", 4)
 }
@@ -3000,7 +3052,7 @@ func TestSendStandupReport_UpdateStatus_True_GetNotificationStatus_Error(t *test
 
 	mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.Anything).Return(&model.Post{})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -3022,7 +3074,7 @@ func TestSendStandupReport_UpdateStatus_True_GetNotificationStatus_Error(t *test
 		}, nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return &standup.UserStandup{
 			UserID:    userID,
 			ChannelID: channelID,
@@ -3033,49 +3085,45 @@ func TestSendStandupReport_UpdateStatus_True_GetNotificationStatus_Error(t *test
 		}, nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return nil, errors.New("")
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		return nil
 	})
 
-	err := SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", true)
+	err := SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", true)
 	assert.Nil(t, err, "should not produce any error")
 
 	monkey.Unpatch(GetNotificationStatus)
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		if channelID == "channel_1" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  false,
-				WindowCloseNotificationSent: false,
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_2" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: false,
+				RemindersSent:     []int{0},
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_3" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: true,
+				RemindersSent:     []int{0, 1},
+				StandupReportSent: false,
 			}, nil
 		}
 
 		t.Fatal("unknown argument encountered: " + channelID)
 		return nil, nil
 	})
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		return errors.New("")
 	})
 
-	err = SendStandupReport([]string{"channel_1", "channel_2"}, otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", true)
+	err = SendStandupReport([]string{"channel_1", "channel_2"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPrivate, "user_1", true)
 	assert.NotNil(t, err, "should not produce any error")
-	mockAPI.AssertNumberOfCalls(t, "KVGet", 3)
+	mockAPI.AssertNumberOfCalls(t, "KVGet", 9)
 	mockAPI.AssertNumberOfCalls(t, "KVSet", 0)
 	mockAPI.AssertNumberOfCalls(t, "KVDelete", 3)
 }
@@ -3085,7 +3133,7 @@ func TestSetNotificationStatus(t *testing.T) {
 	mockAPI := setUp()
 	baseMock(mockAPI)
 	mockAPI.On("KVSet", mock.AnythingOfType("string"), mock.Anything).Return(nil)
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -3106,7 +3154,7 @@ func TestSetNotificationStatus(t *testing.T) {
 			WindowCloseReminderEnabled: true,
 		}, nil
 	})
-	assert.Nil(t, SetNotificationStatus("channel_id_1", &ChannelNotificationStatus{}))
+	assert.Nil(t, SetNotificationStatus("channel_id_1", "", &ChannelNotificationStatus{}))
 }
 
 func TestSetNotificationStatus_JsonMarshal_Error(t *testing.T) {
@@ -3117,7 +3165,7 @@ func TestSetNotificationStatus_JsonMarshal_Error(t *testing.T) {
 	monkey.Patch(json.Marshal, func(v interface{}) ([]byte, error) {
 		return nil, errors.New("")
 	})
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -3139,7 +3187,7 @@ func TestSetNotificationStatus_JsonMarshal_Error(t *testing.T) {
 		}, nil
 	})
 
-	assert.NotNil(t, SetNotificationStatus("channel_id_1", &ChannelNotificationStatus{}))
+	assert.NotNil(t, SetNotificationStatus("channel_id_1", "", &ChannelNotificationStatus{}))
 }
 
 func TestSetNotificationStatus_KVSet_Error(t *testing.T) {
@@ -3147,7 +3195,7 @@ func TestSetNotificationStatus_KVSet_Error(t *testing.T) {
 	mockAPI := setUp()
 	baseMock(mockAPI)
 	mockAPI.On("KVSet", mock.AnythingOfType("string"), mock.Anything).Return(model.NewAppError("", "", nil, "", 0))
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
 		}
@@ -3168,7 +3216,7 @@ func TestSetNotificationStatus_KVSet_Error(t *testing.T) {
 			WindowCloseReminderEnabled: true,
 		}, nil
 	})
-	assert.NotNil(t, SetNotificationStatus("channel_id_1", &ChannelNotificationStatus{}))
+	assert.NotNil(t, SetNotificationStatus("channel_id_1", "", &ChannelNotificationStatus{}))
 }
 
 func TestSendNotificationsAndReports_GetUserStandup_Nodata(t *testing.T) {
@@ -3193,24 +3241,21 @@ func TestSendNotificationsAndReports_GetUserStandup_Nodata(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		if channelID == "channel_1" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: true,
+				RemindersSent:     []int{0, 1},
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_2" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: true,
+				RemindersSent:     []int{0, 1},
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_3" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: true,
+				RemindersSent:     []int{0, 1},
+				StandupReportSent: false,
 			}, nil
 		}
 
@@ -3218,7 +3263,7 @@ func TestSendNotificationsAndReports_GetUserStandup_Nodata(t *testing.T) {
 		return nil, nil
 	})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		if channelID == "channel_1" {
 			windowOpenTime := otime.OTime{
 				Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
@@ -3291,7 +3336,7 @@ func TestSendNotificationsAndReports_GetUserStandup_Nodata(t *testing.T) {
 		return nil, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		if channelID == "channel_1" {
 			return nil
 		} else if channelID == "channel_2" {
@@ -3303,15 +3348,19 @@ func TestSendNotificationsAndReports_GetUserStandup_Nodata(t *testing.T) {
 		t.Fatal("unknown argument encountered: " + channelID)
 		return nil
 	})
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return nil, nil
 	})
-	err := SendStandupReport([]string{"channel_1", "channel_2", "channel_3"}, otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", true)
+	monkey.Patch(getNotificationStatusForDate, func(channelID, standupID, date string) (*ChannelNotificationStatus, error) {
+		return &ChannelNotificationStatus{}, nil
+	})
+
+	err := SendStandupReport([]string{"channel_1", "channel_2", "channel_3"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", true)
 	assert.Nil(t, err, "should not produce any error")
 	assert.Nil(t, SendNotificationsAndReports(), "no error should have been produced")
 	mockAPI.AssertNumberOfCalls(t, "CreatePost", 3)
-	mockAPI.AssertNumberOfCalls(t, "KVGet", 3)
-	mockAPI.AssertNumberOfCalls(t, "KVSet", 0)
+	mockAPI.AssertNumberOfCalls(t, "KVGet", 12)
+	mockAPI.AssertNumberOfCalls(t, "KVSet", 3)
 	mockAPI.AssertNumberOfCalls(t, "KVDelete", 3)
 }
 
@@ -3337,24 +3386,20 @@ func TestSendNotificationsAndReports_MemberNoStandup(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		if channelID == "channel_1" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  false,
-				WindowCloseNotificationSent: false,
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_2" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: false,
+				RemindersSent:     []int{0},
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_3" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: true,
+				RemindersSent:     []int{0, 1},
+				StandupReportSent: false,
 			}, nil
 		}
 
@@ -3362,7 +3407,7 @@ func TestSendNotificationsAndReports_MemberNoStandup(t *testing.T) {
 		return nil, nil
 	})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		if channelID == "channel_1" {
 			windowOpenTime := otime.OTime{
 				Time: otime.Now("Asia/Kolkata").Add(-1 * time.Hour),
@@ -3435,7 +3480,7 @@ func TestSendNotificationsAndReports_MemberNoStandup(t *testing.T) {
 		return nil, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		if channelID == "channel_1" {
 			return nil
 		} else if channelID == "channel_2" {
@@ -3448,7 +3493,7 @@ func TestSendNotificationsAndReports_MemberNoStandup(t *testing.T) {
 		return nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		if channelID == "channel_1" {
 			if userID == "user_id_1" {
 				return nil, nil
@@ -3469,11 +3514,15 @@ func TestSendNotificationsAndReports_MemberNoStandup(t *testing.T) {
 
 		panic(t)
 	})
-	err := SendStandupReport([]string{"channel_1", "channel_2", "channel_3"}, otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", true)
+	monkey.Patch(getNotificationStatusForDate, func(channelID, standupID, date string) (*ChannelNotificationStatus, error) {
+		return &ChannelNotificationStatus{}, nil
+	})
+
+	err := SendStandupReport([]string{"channel_1", "channel_2", "channel_3"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_1", true)
 	assert.Nil(t, err, "should not produce any error")
 	assert.Nil(t, SendNotificationsAndReports(), "no error should have been produced")
-	mockAPI.AssertNumberOfCalls(t, "KVGet", 5)
-	mockAPI.AssertNumberOfCalls(t, "KVSet", 2)
+	mockAPI.AssertNumberOfCalls(t, "KVGet", 18)
+	mockAPI.AssertNumberOfCalls(t, "KVSet", 5)
 	mockAPI.AssertNumberOfCalls(t, "KVDelete", 3)
 }
 
@@ -3498,32 +3547,29 @@ func TestSendNotificationsAndReports_StandupConfig_Error(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(filterChannelNotification, func(channels map[string]string) ([]string, []string, []string, error) {
-		return []string{}, []string{}, []string{"channel_1", "channel_2", "channel_3"}, nil
+	monkey.Patch(filterChannelNotification, func(channels map[string]string) ([]channelStandup, []channelStandup, []channelStandup, error) {
+		return []channelStandup{}, []channelStandup{}, []channelStandup{{ChannelID: "channel_1"}, {ChannelID: "channel_2"}, {ChannelID: "channel_3"}}, nil
 	})
 
-	monkey.Patch(SendStandupReport, func(channelIDs []string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
+	monkey.Patch(SendStandupReport, func(channelIDs []string, standupID string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
 		return nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		if channelID == "channel_1" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: true,
+				RemindersSent:     []int{0, 1},
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_2" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: true,
+				RemindersSent:     []int{0, 1},
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_3" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: true,
+				RemindersSent:     []int{0, 1},
+				StandupReportSent: false,
 			}, nil
 		}
 
@@ -3531,11 +3577,11 @@ func TestSendNotificationsAndReports_StandupConfig_Error(t *testing.T) {
 		return nil, nil
 	})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, errors.New("")
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		if channelID == "channel_1" {
 			return nil
 		} else if channelID == "channel_2" {
@@ -3548,7 +3594,7 @@ func TestSendNotificationsAndReports_StandupConfig_Error(t *testing.T) {
 		return nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		if channelID == "channel_1" {
 			if userID == "user_id_1" || userID == "user_id_2" {
 				return nil, nil
@@ -3591,43 +3637,40 @@ func TestSendNotificationsAndReports_StandupConfig_Nil(t *testing.T) {
 		}, nil
 	})
 
-	monkey.Patch(SendStandupReport, func(channelIDs []string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
+	monkey.Patch(SendStandupReport, func(channelIDs []string, standupID string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
 		return nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		if channelID == "channel_1" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: true,
+				RemindersSent:     []int{0, 1},
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_2" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: true,
+				RemindersSent:     []int{0, 1},
+				StandupReportSent: false,
 			}, nil
 		} else if channelID == "channel_3" {
 			return &ChannelNotificationStatus{
-				StandupReportSent:           false,
-				WindowOpenNotificationSent:  true,
-				WindowCloseNotificationSent: true,
+				RemindersSent:     []int{0, 1},
+				StandupReportSent: false,
 			}, nil
 		}
 
 		t.Fatal("unknown argument encountered: " + channelID)
 		return nil, nil
 	})
-	monkey.Patch(filterChannelNotification, func(channels map[string]string) ([]string, []string, []string, error) {
-		return []string{}, []string{}, []string{"channel_1", "channel_2", "channel_3"}, nil
+	monkey.Patch(filterChannelNotification, func(channels map[string]string) ([]channelStandup, []channelStandup, []channelStandup, error) {
+		return []channelStandup{}, []channelStandup{}, []channelStandup{{ChannelID: "channel_1"}, {ChannelID: "channel_2"}, {ChannelID: "channel_3"}}, nil
 	})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		return nil, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		if channelID == "channel_1" {
 			return nil
 		} else if channelID == "channel_2" {
@@ -3640,7 +3683,7 @@ func TestSendNotificationsAndReports_StandupConfig_Nil(t *testing.T) {
 		return nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		if channelID == "channel_1" {
 			if userID == "user_id_1" || userID == "user_id_2" {
 				return nil, nil
@@ -3682,19 +3725,18 @@ func TestSendNotificationsAndReports_WindowCloseReminderEnabled_Disabled(t *test
 		}, nil
 	})
 
-	monkey.Patch(SendStandupReport, func(channelIDs []string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
+	monkey.Patch(SendStandupReport, func(channelIDs []string, standupID string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
 		return nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{
-			StandupReportSent:           false,
-			WindowOpenNotificationSent:  true,
-			WindowCloseNotificationSent: false,
+			RemindersSent:     []int{0},
+			StandupReportSent: false,
 		}, nil
 	})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-55 * time.Minute),
 		}
@@ -3718,7 +3760,7 @@ func TestSendNotificationsAndReports_WindowCloseReminderEnabled_Disabled(t *test
 		}, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		if channelID == "channel_1" {
 			return nil
 		} else if channelID == "channel_2" {
@@ -3731,7 +3773,7 @@ func TestSendNotificationsAndReports_WindowCloseReminderEnabled_Disabled(t *test
 		return nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return nil, nil
 	})
 
@@ -3759,19 +3801,17 @@ func TestSendNotificationsAndReports_WindowOpenReminderEnabled_Disabled(t *testi
 		}, nil
 	})
 
-	monkey.Patch(SendStandupReport, func(channelIDs []string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
+	monkey.Patch(SendStandupReport, func(channelIDs []string, standupID string, date otime.OTime, visibility string, userId string, updateStatus bool) error {
 		return nil
 	})
 
-	monkey.Patch(GetNotificationStatus, func(channelID string) (*ChannelNotificationStatus, error) {
+	monkey.Patch(GetNotificationStatus, func(channelID, standupID string) (*ChannelNotificationStatus, error) {
 		return &ChannelNotificationStatus{
-			StandupReportSent:           false,
-			WindowOpenNotificationSent:  false,
-			WindowCloseNotificationSent: false,
+			StandupReportSent: false,
 		}, nil
 	})
 
-	monkey.Patch(standup.GetStandupConfig, func(channelID string) (*standup.Config, error) {
+	monkey.Patch(standup.GetStandupConfig, func(channelID, standupID string) (*standup.Config, error) {
 		windowOpenTime := otime.OTime{
 			Time: otime.Now("Asia/Kolkata").Add(-1 * time.Minute),
 		}
@@ -3795,7 +3835,7 @@ func TestSendNotificationsAndReports_WindowOpenReminderEnabled_Disabled(t *testi
 		}, nil
 	})
 
-	monkey.Patch(SetNotificationStatus, func(channelID string, status *ChannelNotificationStatus) error {
+	monkey.Patch(SetNotificationStatus, func(channelID, standupID string, status *ChannelNotificationStatus) error {
 		if channelID == "channel_1" {
 			return nil
 		} else if channelID == "channel_2" {
@@ -3808,7 +3848,7 @@ func TestSendNotificationsAndReports_WindowOpenReminderEnabled_Disabled(t *testi
 		return nil
 	})
 
-	monkey.Patch(standup.GetUserStandup, func(userID, channelID string, date otime.OTime) (*standup.UserStandup, error) {
+	monkey.Patch(standup.GetUserStandup, func(userID, channelID, standupID string, date otime.OTime) (*standup.UserStandup, error) {
 		return nil, nil
 	})
 
@@ -3830,3 +3870,1320 @@ func TestIsStandupDay(t *testing.T) {
 
 	isStandupDay(standupConfig)
 }
+
+func TestSendNotificationsAndReports_MemoryStore(t *testing.T) {
+	defer TearDown()
+	mockAPI := setUp()
+	baseMock(mockAPI)
+	mockAPI.On("CreatePost", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{Id: "post_id"}, nil)
+	mockAPI.On("DeletePost", mock.AnythingOfType("string")).Return(nil)
+	mockAPI.On("GetUser", mock.AnythingOfType("string")).Return(&model.User{Username: "username"}, nil)
+
+	memoryStore := standup.NewMemoryStore()
+	standup.SetStore(memoryStore)
+	defer standup.SetStore(&standup.KVStore{})
+
+	parsedRRule, err := util.ParseRRuleFromString(rruleString, time.Now().Add(-5*24*time.Hour))
+	if err != nil {
+		t.Fatal("Couldn't parse RRULE", err)
+		return
+	}
+
+	// window closing right after midnight so the report is always due
+	windowOpenTime, _ := otime.Parse("00:00")
+	windowCloseTime, _ := otime.Parse("00:01")
+
+	assert.Nil(t, memoryStore.SetStandupChannels(map[string]string{"channel_1": "channel_1"}))
+	assert.Nil(t, memoryStore.SaveStandupConfig(&standup.Config{
+		ChannelID:                  "channel_1",
+		WindowOpenTime:             windowOpenTime,
+		WindowCloseTime:            windowCloseTime,
+		Enabled:                    true,
+		Members:                    []string{"user_id_1", "user_id_2"},
+		ReportFormat:               config.ReportFormatUserAggregated,
+		Sections:                   []string{"section 1"},
+		Timezone:                   "Asia/Kolkata",
+		WindowOpenReminderEnabled:  true,
+		WindowCloseReminderEnabled: true,
+		RRuleString:                rruleString,
+		RRule:                      parsedRRule,
+	}))
+	assert.Nil(t, memoryStore.SaveUserStandup(util.GetCurrentDateString("Asia/Kolkata"), &standup.UserStandup{
+		UserID:    "user_id_1",
+		ChannelID: "channel_1",
+		Standup:   map[string]*[]string{"section 1": {"task 1"}},
+	}))
+
+	assert.Nil(t, SendNotificationsAndReports())
+	mockAPI.AssertNumberOfCalls(t, "CreatePost", 1)
+
+	status, err := GetNotificationStatus("channel_1", "")
+	assert.Nil(t, err)
+	assert.True(t, status.StandupReportSent)
+
+	// report is sent only once
+	assert.Nil(t, SendNotificationsAndReports())
+	mockAPI.AssertNumberOfCalls(t, "CreatePost", 1)
+}
+
+func TestSendNotificationsAndReports_MultipleChannelStandups(t *testing.T) {
+	defer TearDown()
+	mockAPI := setUp()
+	baseMock(mockAPI)
+	mockAPI.On("CreatePost", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{Id: "post_id"}, nil)
+	mockAPI.On("DeletePost", mock.AnythingOfType("string")).Return(nil)
+	mockAPI.On("GetUser", mock.AnythingOfType("string")).Return(&model.User{Username: "username"}, nil)
+
+	memoryStore := standup.NewMemoryStore()
+	standup.SetStore(memoryStore)
+	defer standup.SetStore(&standup.KVStore{})
+
+	parsedRRule, err := util.ParseRRuleFromString(rruleString, time.Now().Add(-5*24*time.Hour))
+	if err != nil {
+		t.Fatal("Couldn't parse RRULE", err)
+		return
+	}
+
+	// window closing right after midnight so the report is always due
+	windowOpenTime, _ := otime.Parse("00:00")
+	windowCloseTime, _ := otime.Parse("00:01")
+
+	assert.Nil(t, memoryStore.SetStandupChannels(map[string]string{
+		standup.StandupKey("channel_1", standup.DefaultStandupID): "channel_1",
+		standup.StandupKey("channel_1", "retro"):                  "channel_1",
+	}))
+
+	for _, standupID := range []string{standup.DefaultStandupID, "retro"} {
+		assert.Nil(t, memoryStore.SaveStandupConfig(&standup.Config{
+			ChannelID:                  "channel_1",
+			StandupID:                  standupID,
+			WindowOpenTime:             windowOpenTime,
+			WindowCloseTime:            windowCloseTime,
+			Enabled:                    true,
+			Members:                    []string{"user_id_1"},
+			ReportFormat:               config.ReportFormatUserAggregated,
+			Sections:                   []string{"section 1"},
+			Timezone:                   "Asia/Kolkata",
+			WindowOpenReminderEnabled:  true,
+			WindowCloseReminderEnabled: true,
+			RRuleString:                rruleString,
+			RRule:                      parsedRRule,
+		}))
+	}
+
+	assert.Nil(t, SendNotificationsAndReports())
+	mockAPI.AssertNumberOfCalls(t, "CreatePost", 2)
+
+	for _, standupID := range []string{standup.DefaultStandupID, "retro"} {
+		status, err := GetNotificationStatus("channel_1", standupID)
+		assert.Nil(t, err)
+		assert.True(t, status.StandupReportSent)
+	}
+
+	var reportTitles []string
+	for _, call := range mockAPI.Calls {
+		if call.Method == "CreatePost" {
+			reportTitles = append(reportTitles, strings.Split(call.Arguments.Get(0).(*model.Post).Message, " for ")[0])
+		}
+	}
+	assert.ElementsMatch(t, []string{"#### Standup Report", "#### Standup Report (retro)"}, reportTitles)
+}
+
+func TestSendNotificationsAndReports_WindowCrossingMidnight(t *testing.T) {
+	defer TearDown()
+	mockAPI := setUp()
+	baseMock(mockAPI)
+	mockAPI.On("CreatePost", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{Id: "post_id"}, nil)
+	mockAPI.On("DeletePost", mock.AnythingOfType("string")).Return(nil)
+	mockAPI.On("GetUser", "user_id_1").Return(&model.User{Username: "john", FirstName: "John"}, nil)
+	mockAPI.On("GetUser", "user_id_2").Return(&model.User{Username: "jane", FirstName: "Jane"}, nil)
+
+	memoryStore := standup.NewMemoryStore()
+	standup.SetStore(memoryStore)
+	defer standup.SetStore(&standup.KVStore{})
+
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	clock := otime.NewFakeClock(time.Date(2020, 1, 6, 23, 0, 0, 0, location))
+	otime.SetClock(clock)
+	defer otime.SetClock(otime.SystemClock)
+
+	parsedRRule, err := util.ParseRRuleFromString("FREQ=DAILY;INTERVAL=1", time.Date(2020, 1, 1, 0, 0, 0, 0, location))
+	if err != nil {
+		t.Fatal("Couldn't parse RRULE", err)
+		return
+	}
+
+	windowOpenTime, _ := otime.Parse("22:00")
+	windowCloseTime, _ := otime.Parse("02:00")
+
+	assert.Nil(t, memoryStore.SetStandupChannels(map[string]string{"channel_1": "channel_1"}))
+	assert.Nil(t, memoryStore.SaveStandupConfig(&standup.Config{
+		ChannelID:                  "channel_1",
+		WindowOpenTime:             windowOpenTime,
+		WindowCloseTime:            windowCloseTime,
+		Enabled:                    true,
+		Members:                    []string{"user_id_1", "user_id_2"},
+		ReportFormat:               config.ReportFormatUserAggregated,
+		Sections:                   []string{"section 1"},
+		Timezone:                   "Asia/Kolkata",
+		WindowOpenReminderEnabled:  true,
+		WindowCloseReminderEnabled: true,
+		RRuleString:                "FREQ=DAILY;INTERVAL=1",
+		RRule:                      parsedRRule,
+	}))
+
+	getMessages := func() []string {
+		var messages []string
+		for _, call := range mockAPI.Calls {
+			if call.Method == "CreatePost" {
+				messages = append(messages, call.Arguments.Get(0).(*model.Post).Message)
+			}
+		}
+
+		return messages
+	}
+
+	// window opened on 6 Jan
+	assert.Nil(t, SendNotificationsAndReports())
+	assert.Equal(t, []string{"Please start filling your standup!"}, getMessages())
+
+	// standup submitted after midnight belongs to the day window opened
+	clock.Set(time.Date(2020, 1, 7, 0, 30, 0, 0, location))
+	assert.Nil(t, standup.SaveUserStandup(&standup.UserStandup{
+		UserID:    "user_id_1",
+		ChannelID: "channel_1",
+		Standup:   map[string]*[]string{"section 1": {"task 1"}},
+	}))
+
+	userStandup, err := memoryStore.GetUserStandup("user_id_1", "channel_1", "", "20200106")
+	assert.Nil(t, err)
+	assert.NotNil(t, userStandup)
+
+	clock.Set(time.Date(2020, 1, 7, 1, 30, 0, 0, location))
+	assert.Nil(t, SendNotificationsAndReports())
+	assert.Equal(t, "@jane - a gentle reminder to fill your standup.", getMessages()[1])
+
+	// report is sent for the day window opened once it closes
+	clock.Set(time.Date(2020, 1, 7, 3, 0, 0, 0, location))
+	assert.Nil(t, SendNotificationsAndReports())
+	assert.Equal(t, 3, len(getMessages()))
+	assert.True(t, strings.HasPrefix(getMessages()[2], "#### Standup Report for *6 Jan 2020*"))
+
+	status, err := memoryStore.GetNotificationStatus("channel_1", "", "20200106")
+	assert.Nil(t, err)
+	assert.True(t, status.StandupReportSent)
+
+	// nothing more until the next window opens
+	clock.Set(time.Date(2020, 1, 7, 21, 0, 0, 0, location))
+	assert.Nil(t, SendNotificationsAndReports())
+	assert.Equal(t, 3, len(getMessages()))
+}
+
+func TestSendNotificationsAndReports_MissedReports(t *testing.T) {
+	defer TearDown()
+	mockAPI := setUp()
+	baseMock(mockAPI)
+	mockAPI.On("CreatePost", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{Id: "post_id"}, nil)
+	mockAPI.On("DeletePost", mock.AnythingOfType("string")).Return(nil)
+	mockAPI.On("GetUser", "user_id_1").Return(&model.User{Username: "john", FirstName: "John"}, nil)
+
+	memoryStore := standup.NewMemoryStore()
+	standup.SetStore(memoryStore)
+	defer standup.SetStore(&standup.KVStore{})
+
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	clock := otime.NewFakeClock(time.Date(2020, 1, 6, 12, 0, 0, 0, location))
+	otime.SetClock(clock)
+	defer otime.SetClock(otime.SystemClock)
+
+	parsedRRule, err := util.ParseRRuleFromString("FREQ=DAILY;INTERVAL=1", time.Date(2020, 1, 1, 0, 0, 0, 0, location))
+	if err != nil {
+		t.Fatal("Couldn't parse RRULE", err)
+		return
+	}
+
+	windowOpenTime, _ := otime.Parse("10:00")
+	windowCloseTime, _ := otime.Parse("11:00")
+
+	assert.Nil(t, memoryStore.SetStandupChannels(map[string]string{"channel_1": "channel_1"}))
+	assert.Nil(t, memoryStore.SaveStandupConfig(&standup.Config{
+		ChannelID:                  "channel_1",
+		WindowOpenTime:             windowOpenTime,
+		WindowCloseTime:            windowCloseTime,
+		Enabled:                    true,
+		Members:                    []string{"user_id_1"},
+		ReportFormat:               config.ReportFormatUserAggregated,
+		Sections:                   []string{"section 1"},
+		Timezone:                   "Asia/Kolkata",
+		WindowOpenReminderEnabled:  true,
+		WindowCloseReminderEnabled: true,
+		RRuleString:                "FREQ=DAILY;INTERVAL=1",
+		RRule:                      parsedRRule,
+		ExDates:                    []string{"2020-01-07"},
+	}))
+
+	getMessages := func() []string {
+		var messages []string
+		for _, call := range mockAPI.Calls {
+			if call.Method == "CreatePost" {
+				messages = append(messages, call.Arguments.Get(0).(*model.Post).Message)
+			}
+		}
+
+		return messages
+	}
+
+	// nothing is caught up on the first run
+	assert.Nil(t, SendNotificationsAndReports())
+	assert.Equal(t, 1, len(getMessages()))
+	assert.True(t, strings.HasPrefix(getMessages()[0], "#### Standup Report for *6 Jan 2020*"))
+
+	lastProcessed, err := memoryStore.GetLastProcessedOccurrence("channel_1", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "2020-01-05", lastProcessed)
+
+	// plugin was down from 6 Jan till window opened on 10 Jan.
+	// Reports of 8 and 9 Jan are sent as delayed, 7 Jan was skipped and
+	// only the reminder of the current standup date is sent.
+	clock.Set(time.Date(2020, 1, 10, 10, 30, 0, 0, location))
+	assert.Nil(t, SendNotificationsAndReports())
+	messages := getMessages()
+	assert.Equal(t, 4, len(messages))
+	assert.Equal(t, "Please start filling your standup!", messages[1])
+	assert.True(t, strings.HasPrefix(messages[2], delayedReportNotice+"#### Standup Report for *8 Jan 2020*"))
+	assert.True(t, strings.HasPrefix(messages[3], delayedReportNotice+"#### Standup Report for *9 Jan 2020*"))
+
+	status, err := memoryStore.GetNotificationStatus("channel_1", "", "20200109")
+	assert.Nil(t, err)
+	assert.True(t, status.StandupReportSent)
+
+	lastProcessed, err = memoryStore.GetLastProcessedOccurrence("channel_1", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "2020-01-09", lastProcessed)
+
+	// delayed reports are sent only once
+	clock.Set(time.Date(2020, 1, 10, 10, 45, 0, 0, location))
+	assert.Nil(t, SendNotificationsAndReports())
+	assert.Equal(t, 4, len(getMessages()))
+}
+
+func TestSendNotificationsAndReports_MissedReports_Lookback(t *testing.T) {
+	defer TearDown()
+	mockAPI := setUp()
+	baseMock(mockAPI)
+	mockAPI.On("CreatePost", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{Id: "post_id"}, nil)
+	mockAPI.On("GetUser", "user_id_1").Return(&model.User{Username: "john", FirstName: "John"}, nil)
+
+	memoryStore := standup.NewMemoryStore()
+	standup.SetStore(memoryStore)
+	defer standup.SetStore(&standup.KVStore{})
+
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	otime.SetClock(otime.NewFakeClock(time.Date(2020, 2, 1, 9, 0, 0, 0, location)))
+	defer otime.SetClock(otime.SystemClock)
+
+	parsedRRule, err := util.ParseRRuleFromString("FREQ=DAILY;INTERVAL=1", time.Date(2020, 1, 1, 0, 0, 0, 0, location))
+	if err != nil {
+		t.Fatal("Couldn't parse RRULE", err)
+		return
+	}
+
+	windowOpenTime, _ := otime.Parse("10:00")
+	windowCloseTime, _ := otime.Parse("11:00")
+
+	standupConfig := &standup.Config{
+		ChannelID:       "channel_1",
+		WindowOpenTime:  windowOpenTime,
+		WindowCloseTime: windowCloseTime,
+		Enabled:         false,
+		Members:         []string{"user_id_1"},
+		ReportFormat:    config.ReportFormatUserAggregated,
+		Sections:        []string{"section 1"},
+		Timezone:        "Asia/Kolkata",
+		RRuleString:     "FREQ=DAILY;INTERVAL=1",
+		RRule:           parsedRRule,
+	}
+
+	assert.Nil(t, memoryStore.SetStandupChannels(map[string]string{"channel_1": "channel_1"}))
+	assert.Nil(t, memoryStore.SaveStandupConfig(standupConfig))
+	assert.Nil(t, memoryStore.SetLastProcessedOccurrence("channel_1", "", "2020-01-02"))
+
+	// reports of a disabled standup are never caught up
+	assert.Nil(t, SendNotificationsAndReports())
+	assert.Equal(t, 0, len(mockAPI.Calls))
+
+	lastProcessed, err := memoryStore.GetLastProcessedOccurrence("channel_1", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "", lastProcessed)
+
+	// only reports within the lookback period are caught up
+	standupConfig.Enabled = true
+	assert.Nil(t, memoryStore.SaveStandupConfig(standupConfig))
+	assert.Nil(t, memoryStore.SetLastProcessedOccurrence("channel_1", "", "2020-01-02"))
+
+	assert.Nil(t, SendNotificationsAndReports())
+	messages := []string{}
+	for _, call := range mockAPI.Calls {
+		if call.Method == "CreatePost" {
+			messages = append(messages, call.Arguments.Get(0).(*model.Post).Message)
+		}
+	}
+
+	assert.Equal(t, missedReportLookbackDays, len(messages))
+	assert.True(t, strings.HasPrefix(messages[0], delayedReportNotice+"#### Standup Report for *25 Jan 2020*"))
+	assert.True(t, strings.HasPrefix(messages[len(messages)-1], delayedReportNotice+"#### Standup Report for *31 Jan 2020*"))
+}
+
+func TestSendNotificationsAndReports_ReminderSchedule(t *testing.T) {
+	defer TearDown()
+	mockAPI := setUp()
+	baseMock(mockAPI)
+	mockAPI.On("CreatePost", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{Id: "post_id"}, nil)
+	mockAPI.On("GetUser", "user_id_1").Return(&model.User{Username: "john", FirstName: "John"}, nil)
+	mockAPI.On("GetUser", "user_id_2").Return(&model.User{Username: "jane", FirstName: "Jane"}, nil)
+
+	memoryStore := standup.NewMemoryStore()
+	standup.SetStore(memoryStore)
+	defer standup.SetStore(&standup.KVStore{})
+
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	clock := otime.NewFakeClock(time.Date(2020, 1, 6, 10, 20, 0, 0, location))
+	otime.SetClock(clock)
+	defer otime.SetClock(otime.SystemClock)
+
+	parsedRRule, err := util.ParseRRuleFromString("FREQ=DAILY;INTERVAL=1", time.Date(2020, 1, 1, 0, 0, 0, 0, location))
+	if err != nil {
+		t.Fatal("Couldn't parse RRULE", err)
+		return
+	}
+
+	windowOpenTime, _ := otime.Parse("10:00")
+	windowCloseTime, _ := otime.Parse("11:00")
+
+	assert.Nil(t, memoryStore.SetStandupChannels(map[string]string{"channel_1": "channel_1"}))
+	assert.Nil(t, memoryStore.SaveStandupConfig(&standup.Config{
+		ChannelID:                  "channel_1",
+		WindowOpenTime:             windowOpenTime,
+		WindowCloseTime:            windowCloseTime,
+		Enabled:                    true,
+		Members:                    []string{"user_id_1", "user_id_2"},
+		ReportFormat:               config.ReportFormatUserAggregated,
+		Sections:                   []string{"section 1"},
+		Timezone:                   "Asia/Kolkata",
+		WindowOpenReminderEnabled:  true,
+		WindowCloseReminderEnabled: true,
+		RRuleString:                "FREQ=DAILY;INTERVAL=1",
+		RRule:                      parsedRRule,
+		Reminders: []standup.Reminder{
+			{Anchor: standup.ReminderAnchorOpen, Minutes: 30},
+			{Anchor: standup.ReminderAnchorClose, Minutes: 15},
+			{Anchor: standup.ReminderAnchorClose, Minutes: 5},
+		},
+	}))
+
+	getMessages := func() []string {
+		var messages []string
+		for _, call := range mockAPI.Calls {
+			if call.Method == "CreatePost" {
+				messages = append(messages, call.Arguments.Get(0).(*model.Post).Message)
+			}
+		}
+
+		return messages
+	}
+
+	// no window open reminder is configured
+	assert.Nil(t, SendNotificationsAndReports())
+	assert.Empty(t, getMessages())
+
+	clock.Set(time.Date(2020, 1, 6, 10, 31, 0, 0, location))
+	assert.Nil(t, SendNotificationsAndReports())
+	assert.Equal(t, []string{"@john, @jane - a gentle reminder to fill your standup."}, getMessages())
+
+	clock.Set(time.Date(2020, 1, 6, 10, 40, 0, 0, location))
+	assert.Nil(t, standup.SaveUserStandup(&standup.UserStandup{
+		UserID:    "user_id_1",
+		ChannelID: "channel_1",
+		Standup:   map[string]*[]string{"section 1": {"task 1"}},
+	}))
+	assert.Nil(t, SendNotificationsAndReports())
+	assert.Equal(t, 1, len(getMessages()))
+
+	clock.Set(time.Date(2020, 1, 6, 10, 46, 0, 0, location))
+	assert.Nil(t, SendNotificationsAndReports())
+	assert.Equal(t, 2, len(getMessages()))
+	assert.Equal(t, "@jane - a gentle reminder to fill your standup.", getMessages()[1])
+
+	clock.Set(time.Date(2020, 1, 6, 10, 56, 0, 0, location))
+	assert.Nil(t, SendNotificationsAndReports())
+	assert.Nil(t, SendNotificationsAndReports())
+	assert.Equal(t, 3, len(getMessages()))
+	assert.Equal(t, "@jane - a gentle reminder to fill your standup.", getMessages()[2])
+
+	status, err := memoryStore.GetNotificationStatus("channel_1", "", "20200106")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 1, 2}, status.RemindersSent)
+}
+
+func TestSendNotificationsAndReports_OutOfOffice(t *testing.T) {
+	defer TearDown()
+	mockAPI := setUp()
+	baseMock(mockAPI)
+	mockAPI.On("CreatePost", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{Id: "post_id"}, nil)
+	mockAPI.On("DeletePost", mock.AnythingOfType("string")).Return(nil)
+	mockAPI.On("GetUser", "user_id_1").Return(&model.User{Username: "john", FirstName: "John"}, nil)
+	mockAPI.On("GetUser", "user_id_2").Return(&model.User{Username: "jane", FirstName: "Jane"}, nil)
+	mockAPI.On("GetUser", "user_id_3").Return(&model.User{Username: "jack", FirstName: "Jack"}, nil)
+
+	memoryStore := standup.NewMemoryStore()
+	standup.SetStore(memoryStore)
+	defer standup.SetStore(&standup.KVStore{})
+
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	clock := otime.NewFakeClock(time.Date(2020, 1, 6, 10, 50, 0, 0, location))
+	otime.SetClock(clock)
+	defer otime.SetClock(otime.SystemClock)
+
+	parsedRRule, err := util.ParseRRuleFromString("FREQ=DAILY;INTERVAL=1", time.Date(2020, 1, 1, 0, 0, 0, 0, location))
+	if err != nil {
+		t.Fatal("Couldn't parse RRULE", err)
+		return
+	}
+
+	windowOpenTime, _ := otime.Parse("10:00")
+	windowCloseTime, _ := otime.Parse("11:00")
+
+	assert.Nil(t, memoryStore.SetStandupChannels(map[string]string{"channel_1": "channel_1"}))
+	assert.Nil(t, memoryStore.SaveStandupConfig(&standup.Config{
+		ChannelID:                  "channel_1",
+		WindowOpenTime:             windowOpenTime,
+		WindowCloseTime:            windowCloseTime,
+		Enabled:                    true,
+		Members:                    []string{"user_id_1", "user_id_2", "user_id_3"},
+		ReportFormat:               config.ReportFormatUserAggregated,
+		Sections:                   []string{"section 1"},
+		Timezone:                   "Asia/Kolkata",
+		WindowOpenReminderEnabled:  true,
+		WindowCloseReminderEnabled: true,
+		RRuleString:                "FREQ=DAILY;INTERVAL=1",
+		RRule:                      parsedRRule,
+	}))
+
+	assert.Nil(t, standup.SetUserOutOfOffice("user_id_2", time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 8, 0, 0, 0, 0, time.UTC)))
+	assert.Nil(t, standup.SetUserOutOfOffice("user_id_3", time.Date(2020, 1, 7, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 8, 0, 0, 0, 0, time.UTC)))
+	assert.Nil(t, memoryStore.SaveUserStandup("20200106", &standup.UserStandup{
+		UserID:    "user_id_3",
+		ChannelID: "channel_1",
+		Standup:   map[string]*[]string{"section 1": {"task 1"}},
+	}))
+
+	getMessages := func() []string {
+		var messages []string
+		for _, call := range mockAPI.Calls {
+			if call.Method == "CreatePost" {
+				messages = append(messages, call.Arguments.Get(0).(*model.Post).Message)
+			}
+		}
+
+		return messages
+	}
+
+	// members out of office aren't reminded
+	assert.Nil(t, SendNotificationsAndReports())
+	assert.Equal(t, []string{"@john - a gentle reminder to fill your standup."}, getMessages())
+
+	clock.Set(time.Date(2020, 1, 6, 11, 1, 0, 0, location))
+	assert.Nil(t, SendNotificationsAndReports())
+	assert.Equal(t, 2, len(getMessages()))
+	assert.Equal(
+		t,
+		"#### Standup Report for *6 Jan 2020*\n\n:palm_tree: **Out of office:** jane\n\n@john has not submitted their standup\n\n",
+		getMessages()[1][:strings.Index(getMessages()[1], "#### ![")],
+	)
+}
+
+func TestSendNotificationsAndReports_ReplayWeek(t *testing.T) {
+	defer TearDown()
+	mockAPI := setUp()
+	baseMock(mockAPI)
+	mockAPI.On("CreatePost", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{Id: "post_id"}, nil)
+	mockAPI.On("DeletePost", mock.AnythingOfType("string")).Return(nil)
+	mockAPI.On("GetUser", "user_id_1").Return(&model.User{Username: "john", FirstName: "John"}, nil)
+	mockAPI.On("GetUser", "user_id_2").Return(&model.User{Username: "jane", FirstName: "Jane"}, nil)
+
+	memoryStore := standup.NewMemoryStore()
+	standup.SetStore(memoryStore)
+	defer standup.SetStore(&standup.KVStore{})
+
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	// a Monday
+	clock := otime.NewFakeClock(time.Date(2020, 1, 6, 0, 0, 0, 0, location))
+	otime.SetClock(clock)
+	defer otime.SetClock(otime.SystemClock)
+
+	windowOpenTime, _ := otime.Parse("10:00")
+	windowCloseTime, _ := otime.Parse("11:00")
+
+	assert.Nil(t, memoryStore.SetStandupChannels(map[string]string{"channel_1": "channel_1"}))
+	standupConfig := &standup.Config{
+		ChannelID:                  "channel_1",
+		WindowOpenTime:             windowOpenTime,
+		WindowCloseTime:            windowCloseTime,
+		Enabled:                    true,
+		Members:                    []string{"user_id_1", "user_id_2"},
+		ReportFormat:               config.ReportFormatUserAggregated,
+		Sections:                   []string{"section 1"},
+		Timezone:                   "Asia/Kolkata",
+		WindowOpenReminderEnabled:  true,
+		WindowCloseReminderEnabled: true,
+		RRuleString:                "FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,TU,WE,TH,FR",
+		StartDate:                  time.Date(2020, 1, 1, 0, 0, 0, 0, location),
+		ExDates:                    []string{"2020-01-08"},
+	}
+	assert.Nil(t, standupConfig.PreSave())
+	assert.Nil(t, memoryStore.SaveStandupConfig(standupConfig))
+
+	// running the scheduler every 5 minutes for a week,
+	// with john filling his standup every morning
+	assert.Nil(t, Simulate(clock, clock.Now().AddDate(0, 0, 7), 5*time.Minute, func(now time.Time) error {
+		if now.Hour() != 10 || now.Minute() != 15 {
+			return nil
+		}
+
+		return standup.SaveUserStandup(&standup.UserStandup{
+			UserID:    "user_id_1",
+			ChannelID: "channel_1",
+			Standup:   map[string]*[]string{"section 1": {"task 1"}},
+		})
+	}))
+
+	var windowOpenReminders, pendingReminders, reports []string
+	for _, call := range mockAPI.Calls {
+		if call.Method != "CreatePost" {
+			continue
+		}
+
+		message := call.Arguments.Get(0).(*model.Post).Message
+		switch {
+		case strings.HasPrefix(message, "#### Standup Report for"):
+			reports = append(reports, strings.SplitN(message, "\n", 2)[0])
+		case message == "@jane - a gentle reminder to fill your standup.":
+			pendingReminders = append(pendingReminders, message)
+		default:
+			windowOpenReminders = append(windowOpenReminders, message)
+		}
+	}
+
+	// no standup on the skipped Wednesday and on the weekend
+	assert.Equal(t, []string{
+		"#### Standup Report for *6 Jan 2020*",
+		"#### Standup Report for *7 Jan 2020*",
+		"#### Standup Report for *9 Jan 2020*",
+		"#### Standup Report for *10 Jan 2020*",
+	}, reports)
+	assert.Equal(t, 4, len(windowOpenReminders))
+	assert.Equal(t, 4, len(pendingReminders))
+}
+
+func TestSendNotificationsAndReports_DaylightSaving(t *testing.T) {
+	defer TearDown()
+	mockAPI := setUp()
+	baseMock(mockAPI)
+	mockAPI.On("CreatePost", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{Id: "post_id"}, nil)
+	mockAPI.On("DeletePost", mock.AnythingOfType("string")).Return(nil)
+	mockAPI.On("GetUser", "user_id_1").Return(&model.User{Username: "john", FirstName: "John"}, nil)
+
+	memoryStore := standup.NewMemoryStore()
+	standup.SetStore(memoryStore)
+	defer standup.SetStore(&standup.KVStore{})
+	defer otime.SetClock(otime.SystemClock)
+
+	newYork, _ := time.LoadLocation("America/New_York")
+	// clocks are put forward on 8 March and back on 1 November
+	for _, start := range []time.Time{
+		time.Date(2020, 3, 7, 0, 0, 0, 0, newYork),
+		time.Date(2020, 10, 31, 0, 0, 0, 0, newYork),
+	} {
+		mockAPI.Calls = nil
+		clock := otime.NewFakeClock(start)
+		otime.SetClock(clock)
+
+		windowOpenTime, _ := otime.Parse("09:00")
+		windowCloseTime, _ := otime.Parse("10:00")
+
+		standupConfig := &standup.Config{
+			ChannelID:                  "channel_1",
+			WindowOpenTime:             windowOpenTime,
+			WindowCloseTime:            windowCloseTime,
+			Enabled:                    true,
+			Members:                    []string{"user_id_1"},
+			ReportFormat:               config.ReportFormatUserAggregated,
+			Sections:                   []string{"section 1"},
+			Timezone:                   "America/New_York",
+			WindowOpenReminderEnabled:  true,
+			WindowCloseReminderEnabled: true,
+			RRuleString:                "FREQ=DAILY;INTERVAL=1",
+			StartDate:                  start,
+		}
+		assert.Nil(t, standupConfig.PreSave())
+		assert.Nil(t, memoryStore.SetStandupChannels(map[string]string{"channel_1": "channel_1"}))
+		assert.Nil(t, memoryStore.SaveStandupConfig(standupConfig))
+
+		// local times of the day posts were made at
+		var postTimes []string
+		for end := start.AddDate(0, 0, 2); clock.Now().Before(end); clock.Advance(5 * time.Minute) {
+			calls := len(mockAPI.Calls)
+			assert.Nil(t, SendNotificationsAndReports())
+
+			for _, call := range mockAPI.Calls[calls:] {
+				if call.Method == "CreatePost" {
+					postTimes = append(postTimes, clock.Now().In(newYork).Format("02 Jan 15:04"))
+				}
+			}
+		}
+
+		day, nextDay := start.Format("02 Jan"), start.AddDate(0, 0, 1).Format("02 Jan")
+		assert.Equal(t, []string{
+			day + " 09:05",
+			day + " 09:50",
+			day + " 10:05",
+			nextDay + " 09:05",
+			nextDay + " 09:50",
+			nextDay + " 10:05",
+		}, postTimes)
+	}
+}
+
+func TestSendNotificationsAndReports_DirectMessageReminders(t *testing.T) {
+	defer TearDown()
+	mockAPI := setUp()
+	baseMock(mockAPI)
+	mockAPI.On("CreatePost", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{Id: "post_id"}, nil)
+	mockAPI.On("GetChannel", "channel_1").Return(&model.Channel{Id: "channel_1", Name: "team-a"}, nil)
+	mockAPI.On("GetDirectChannel", "user_id_1", mock.Anything).Return(&model.Channel{Id: "dm_user_id_1"}, nil)
+	mockAPI.On("GetDirectChannel", "user_id_2", mock.Anything).Return(&model.Channel{Id: "dm_user_id_2"}, nil)
+	mockAPI.On("GetUser", "user_id_1").Return(&model.User{Username: "john", FirstName: "John"}, nil)
+	mockAPI.On("GetUser", "user_id_2").Return(&model.User{Username: "jane", FirstName: "Jane"}, nil)
+
+	memoryStore := standup.NewMemoryStore()
+	standup.SetStore(memoryStore)
+	defer standup.SetStore(&standup.KVStore{})
+
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	clock := otime.NewFakeClock(time.Date(2020, 1, 6, 10, 5, 0, 0, location))
+	otime.SetClock(clock)
+	defer otime.SetClock(otime.SystemClock)
+
+	windowOpenTime, _ := otime.Parse("10:00")
+	windowCloseTime, _ := otime.Parse("11:00")
+
+	standupConfig := &standup.Config{
+		ChannelID:                  "channel_1",
+		WindowOpenTime:             windowOpenTime,
+		WindowCloseTime:            windowCloseTime,
+		Enabled:                    true,
+		Members:                    []string{"user_id_1", "user_id_2"},
+		ReportFormat:               config.ReportFormatUserAggregated,
+		Sections:                   []string{"section 1"},
+		Timezone:                   "Asia/Kolkata",
+		WindowOpenReminderEnabled:  true,
+		WindowCloseReminderEnabled: true,
+		RRuleString:                "FREQ=DAILY;INTERVAL=1",
+		StartDate:                  time.Date(2020, 1, 1, 0, 0, 0, 0, location),
+		DirectMessageReminders:     true,
+	}
+	assert.Nil(t, standupConfig.PreSave())
+	assert.Nil(t, memoryStore.SetStandupChannels(map[string]string{"channel_1": "channel_1"}))
+	assert.Nil(t, memoryStore.SaveStandupConfig(standupConfig))
+
+	getPosts := func() []*model.Post {
+		var posts []*model.Post
+		for _, call := range mockAPI.Calls {
+			if call.Method == "CreatePost" {
+				posts = append(posts, call.Arguments.Get(0).(*model.Post))
+			}
+		}
+
+		return posts
+	}
+
+	assert.Nil(t, SendNotificationsAndReports())
+	posts := getPosts()
+	assert.Equal(t, 2, len(posts))
+	assert.Equal(t, "dm_user_id_1", posts[0].ChannelId)
+	assert.Equal(t, "dm_user_id_2", posts[1].ChannelId)
+	assert.Equal(t, "Please start filling your standup in ~team-a!", posts[0].Message)
+
+	attachments := posts[0].Attachments()
+	assert.Equal(t, 1, len(attachments))
+	assert.Equal(t, 2, len(attachments[0].Actions))
+	assert.Equal(t, "Fill standup", attachments[0].Actions[0].Name)
+	assert.Equal(t, "Skip today", attachments[0].Actions[1].Name)
+	assert.Equal(t, map[string]interface{}{"channel_id": "channel_1", "standup_id": ""}, attachments[0].Actions[0].Integration.Context)
+
+	clock.Set(time.Date(2020, 1, 6, 10, 30, 0, 0, location))
+	assert.Nil(t, standup.SaveUserStandup(&standup.UserStandup{
+		UserID:    "user_id_1",
+		ChannelID: "channel_1",
+		Standup:   map[string]*[]string{"section 1": {"task 1"}},
+	}))
+
+	// only the member yet to fill their standup is reminded
+	clock.Set(time.Date(2020, 1, 6, 10, 50, 0, 0, location))
+	assert.Nil(t, SendNotificationsAndReports())
+	posts = getPosts()
+	assert.Equal(t, 3, len(posts))
+	assert.Equal(t, "dm_user_id_2", posts[2].ChannelId)
+	assert.Equal(t, "A gentle reminder to fill your standup in ~team-a.", posts[2].Message)
+
+	// reminders aren't posted in the channel
+	reminderPosts, err := memoryStore.GetReminderPosts("channel_1", "")
+	assert.Nil(t, err)
+	assert.Empty(t, reminderPosts)
+}
+
+func TestSendNotificationsAndReports_SkipStandup(t *testing.T) {
+	defer TearDown()
+	mockAPI := setUp()
+	baseMock(mockAPI)
+	mockAPI.On("CreatePost", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{Id: "post_id"}, nil)
+	mockAPI.On("DeletePost", "post_id").Return(nil)
+	mockAPI.On("GetUser", "user_id_1").Return(&model.User{Username: "john", FirstName: "John"}, nil)
+	mockAPI.On("GetUser", "user_id_2").Return(&model.User{Username: "jane", FirstName: "Jane"}, nil)
+
+	memoryStore := standup.NewMemoryStore()
+	standup.SetStore(memoryStore)
+	defer standup.SetStore(&standup.KVStore{})
+
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	clock := otime.NewFakeClock(time.Date(2020, 1, 6, 10, 5, 0, 0, location))
+	otime.SetClock(clock)
+	defer otime.SetClock(otime.SystemClock)
+
+	windowOpenTime, _ := otime.Parse("10:00")
+	windowCloseTime, _ := otime.Parse("11:00")
+
+	standupConfig := &standup.Config{
+		ChannelID:                  "channel_1",
+		WindowOpenTime:             windowOpenTime,
+		WindowCloseTime:            windowCloseTime,
+		Enabled:                    true,
+		Members:                    []string{"user_id_1", "user_id_2"},
+		ReportFormat:               config.ReportFormatUserAggregated,
+		Sections:                   []string{"section 1"},
+		Timezone:                   "Asia/Kolkata",
+		WindowOpenReminderEnabled:  true,
+		WindowCloseReminderEnabled: true,
+		RRuleString:                "FREQ=DAILY;INTERVAL=1",
+		StartDate:                  time.Date(2020, 1, 1, 0, 0, 0, 0, location),
+	}
+	assert.Nil(t, standupConfig.PreSave())
+	assert.Nil(t, memoryStore.SetStandupChannels(map[string]string{"channel_1": "channel_1"}))
+	assert.Nil(t, memoryStore.SaveStandupConfig(standupConfig))
+
+	getPosts := func() []*model.Post {
+		var posts []*model.Post
+		for _, call := range mockAPI.Calls {
+			if call.Method == "CreatePost" {
+				posts = append(posts, call.Arguments.Get(0).(*model.Post))
+			}
+		}
+
+		return posts
+	}
+
+	assert.Nil(t, SendNotificationsAndReports())
+	posts := getPosts()
+	assert.Equal(t, 1, len(posts))
+	assert.Equal(t, "channel_1", posts[0].ChannelId)
+
+	attachments := posts[0].Attachments()
+	assert.Equal(t, 1, len(attachments))
+	assert.Equal(t, 2, len(attachments[0].Actions))
+	assert.Equal(t, "Skip today", attachments[0].Actions[1].Name)
+	assert.Equal(t, config.URLPluginBase+config.PathActionSkipStandup, attachments[0].Actions[1].Integration.URL)
+
+	_, err := SkipMemberStandup(standupConfig, "user_id_3")
+	assert.NotNil(t, err, "only standup members can skip their standup")
+
+	date, err := SkipMemberStandup(standupConfig, "user_id_2")
+	assert.Nil(t, err)
+	assert.Equal(t, "2020-01-06", date.Format(otime.LayoutISODate))
+
+	// skipping again is a no-op
+	_, err = SkipMemberStandup(standupConfig, "user_id_2")
+	assert.Nil(t, err)
+
+	// only the member who neither filled nor skipped their standup is reminded
+	clock.Set(time.Date(2020, 1, 6, 10, 50, 0, 0, location))
+	assert.Nil(t, SendNotificationsAndReports())
+	posts = getPosts()
+	assert.Equal(t, 2, len(posts))
+	assert.True(t, strings.HasPrefix(posts[1].Message, "@john - "), posts[1].Message)
+
+	clock.Set(time.Date(2020, 1, 6, 11, 5, 0, 0, location))
+	assert.Nil(t, SendNotificationsAndReports())
+	posts = getPosts()
+	assert.Equal(t, 3, len(posts))
+	assert.Contains(t, posts[2].Message, ":fast_forward: **Skipped:** jane\n")
+
+	_, err = SkipMemberStandup(standupConfig, "user_id_1")
+	assert.NotNil(t, err, "standup can't be skipped once report is posted")
+}
+
+func TestSendNotificationsAndReports_CustomReminderMessages(t *testing.T) {
+	defer TearDown()
+	mockAPI := setUp()
+	baseMock(mockAPI)
+	mockAPI.On("CreatePost", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{Id: "post_id"}, nil)
+	mockAPI.On("GetChannel", "channel_1").Return(&model.Channel{Id: "channel_1", Name: "team-a"}, nil)
+	mockAPI.On("GetUser", "user_id_1").Return(&model.User{Username: "john", FirstName: "John"}, nil)
+	mockAPI.On("GetUser", "user_id_2").Return(&model.User{Username: "jane", FirstName: "Jane"}, nil)
+
+	memoryStore := standup.NewMemoryStore()
+	standup.SetStore(memoryStore)
+	defer standup.SetStore(&standup.KVStore{})
+
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	clock := otime.NewFakeClock(time.Date(2020, 1, 6, 10, 5, 0, 0, location))
+	otime.SetClock(clock)
+	defer otime.SetClock(otime.SystemClock)
+
+	windowOpenTime, _ := otime.Parse("10:00")
+	windowCloseTime, _ := otime.Parse("11:00")
+
+	standupConfig := &standup.Config{
+		ChannelID:                  "channel_1",
+		WindowOpenTime:             windowOpenTime,
+		WindowCloseTime:            windowCloseTime,
+		Enabled:                    true,
+		Members:                    []string{"user_id_1", "user_id_2"},
+		ReportFormat:               config.ReportFormatUserAggregated,
+		Sections:                   []string{"section 1"},
+		Timezone:                   "Asia/Kolkata",
+		WindowOpenReminderEnabled:  true,
+		WindowCloseReminderEnabled: true,
+		RRuleString:                "FREQ=DAILY;INTERVAL=1",
+		StartDate:                  time.Date(2020, 1, 1, 0, 0, 0, 0, location),
+		WindowOpenMessage:          "Standup in ~{{.ChannelName}} is open until {{.WindowCloseTime}} for @{{join .PendingMembers \", @\"}}.",
+		// valid for the sample data used in validation, but fails to render with a single pending member
+		WindowCloseMessage: "@{{index .PendingMembers 1}}, hurry up!",
+	}
+	assert.Nil(t, standupConfig.PreSave())
+	assert.Nil(t, standupConfig.IsValid())
+	assert.Nil(t, memoryStore.SetStandupChannels(map[string]string{"channel_1": "channel_1"}))
+	assert.Nil(t, memoryStore.SaveStandupConfig(standupConfig))
+
+	getPosts := func() []*model.Post {
+		var posts []*model.Post
+		for _, call := range mockAPI.Calls {
+			if call.Method == "CreatePost" {
+				posts = append(posts, call.Arguments.Get(0).(*model.Post))
+			}
+		}
+
+		return posts
+	}
+
+	assert.Nil(t, SendNotificationsAndReports())
+	posts := getPosts()
+	assert.Equal(t, 1, len(posts))
+	assert.Equal(t, "Standup in ~team-a is open until 11:00 for @jane, @john.", posts[0].Message)
+
+	clock.Set(time.Date(2020, 1, 6, 10, 30, 0, 0, location))
+	assert.Nil(t, standup.SaveUserStandup(&standup.UserStandup{
+		UserID:    "user_id_1",
+		ChannelID: "channel_1",
+		Standup:   map[string]*[]string{"section 1": {"task 1"}},
+	}))
+
+	// built-in message is sent as the template fails to render
+	clock.Set(time.Date(2020, 1, 6, 10, 50, 0, 0, location))
+	assert.Nil(t, SendNotificationsAndReports())
+	posts = getPosts()
+	assert.Equal(t, 2, len(posts))
+	assert.Equal(t, "@jane - a gentle reminder to fill your standup.", posts[1].Message)
+}
+
+func TestSendStandupReport_ReportFormatCustom(t *testing.T) {
+	defer TearDown()
+	mockAPI := setUp()
+	baseMock(mockAPI)
+	mockAPI.On("CreatePost", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{Id: "post_id"}, nil)
+	mockAPI.On("GetUser", "user_id_1").Return(&model.User{Username: "john", FirstName: "John", LastName: "Doe"}, nil)
+	mockAPI.On("GetUser", "user_id_2").Return(&model.User{Username: "jane", FirstName: "Jane"}, nil)
+	mockAPI.On("GetUser", "user_id_3").Return(&model.User{Username: "jack", FirstName: "Jack"}, nil)
+
+	memoryStore := standup.NewMemoryStore()
+	standup.SetStore(memoryStore)
+	defer standup.SetStore(&standup.KVStore{})
+
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	clock := otime.NewFakeClock(time.Date(2020, 1, 6, 11, 5, 0, 0, location))
+	otime.SetClock(clock)
+	defer otime.SetClock(otime.SystemClock)
+
+	windowOpenTime, _ := otime.Parse("10:00")
+	windowCloseTime, _ := otime.Parse("11:00")
+
+	standupConfig := &standup.Config{
+		ChannelID:                  "channel_1",
+		WindowOpenTime:             windowOpenTime,
+		WindowCloseTime:            windowCloseTime,
+		Enabled:                    true,
+		Members:                    []string{"user_id_1", "user_id_2", "user_id_3"},
+		ReportFormat:               config.ReportFormatCustom,
+		Sections:                   []string{"Yesterday", "Today"},
+		Timezone:                   "Asia/Kolkata",
+		WindowOpenReminderEnabled:  true,
+		WindowCloseReminderEnabled: true,
+		RRuleString:                "FREQ=DAILY;INTERVAL=1",
+		StartDate:                  time.Date(2020, 1, 1, 0, 0, 0, 0, location),
+		ReportTemplate: "{{.Title}} - {{.Date.Format \"Mon 2 Jan\"}}\n" +
+			"{{range .Members}}{{.DisplayName}}:{{range .Sections}} {{.Title}} ({{join .Tasks \", \"}}){{end}}\n{{end}}" +
+			"{{.Stats.SubmissionCount}} of {{.Stats.MemberCount}} submitted {{.Stats.TaskCount}} tasks. " +
+			"Missing: {{join .MembersNoStandup \", \"}}. Out of office: {{join .MembersOutOfOffice \", \"}}.",
+	}
+	assert.Nil(t, standupConfig.PreSave())
+	assert.Nil(t, standupConfig.IsValid())
+	assert.Nil(t, memoryStore.SaveStandupConfig(standupConfig))
+
+	assert.Nil(t, standup.SetUserOutOfOffice("user_id_3", time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)))
+	assert.Nil(t, memoryStore.SaveUserStandup("20200106", &standup.UserStandup{
+		UserID:    "user_id_1",
+		ChannelID: "channel_1",
+		Standup:   map[string]*[]string{"Yesterday": {"task 1", "task 2"}, "Today": {"task 3"}},
+	}))
+
+	getMessages := func() []string {
+		var messages []string
+		for _, call := range mockAPI.Calls {
+			if call.Method == "CreatePost" {
+				messages = append(messages, call.Arguments.Get(0).(*model.Post).Message)
+			}
+		}
+
+		return messages
+	}
+
+	assert.Nil(t, SendStandupReport([]string{"channel_1"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "", false))
+	assert.Equal(t, []string{
+		"Standup Report - Mon 6 Jan\n" +
+			"John Doe: Yesterday (task 1, task 2) Today (task 3)\n" +
+			"1 of 3 submitted 3 tasks. Missing: jane. Out of office: jack.",
+	}, getMessages())
+
+	// user aggregated report is sent if the template fails to render
+	standupConfig.ReportTemplate = "{{index .Members 1}}"
+	assert.Nil(t, memoryStore.SaveStandupConfig(standupConfig))
+	assert.Nil(t, SendStandupReport([]string{"channel_1"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "", false))
+	assert.Equal(t, 2, len(getMessages()))
+	assert.True(t, strings.HasPrefix(getMessages()[1], "#### Standup Report for *6 Jan 2020*\n"), getMessages()[1])
+}
+
+func TestPostLateStandup(t *testing.T) {
+	defer TearDown()
+	mockAPI := setUp()
+	baseMock(mockAPI)
+	mockAPI.On("CreatePost", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{Id: "post_id"}, nil)
+	mockAPI.On("DeletePost", mock.AnythingOfType("string")).Return(nil)
+	mockAPI.On("GetUser", "user_id_1").Return(&model.User{Username: "john", FirstName: "John"}, nil)
+	mockAPI.On("GetUser", "user_id_2").Return(&model.User{Username: "jane", FirstName: "Jane"}, nil)
+
+	memoryStore := standup.NewMemoryStore()
+	standup.SetStore(memoryStore)
+	defer standup.SetStore(&standup.KVStore{})
+
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	clock := otime.NewFakeClock(time.Date(2020, 1, 6, 10, 30, 0, 0, location))
+	otime.SetClock(clock)
+	defer otime.SetClock(otime.SystemClock)
+
+	windowOpenTime, _ := otime.Parse("10:00")
+	windowCloseTime, _ := otime.Parse("11:00")
+
+	standupConfig := &standup.Config{
+		ChannelID:                  "channel_1",
+		WindowOpenTime:             windowOpenTime,
+		WindowCloseTime:            windowCloseTime,
+		Enabled:                    true,
+		Members:                    []string{"user_id_1", "user_id_2"},
+		ReportFormat:               config.ReportFormatUserAggregated,
+		Sections:                   []string{"section 1"},
+		Timezone:                   "Asia/Kolkata",
+		WindowOpenReminderEnabled:  false,
+		WindowCloseReminderEnabled: false,
+		RRuleString:                "FREQ=DAILY;INTERVAL=1",
+		StartDate:                  time.Date(2020, 1, 1, 0, 0, 0, 0, location),
+	}
+	assert.Nil(t, standupConfig.PreSave())
+	assert.Nil(t, memoryStore.SetStandupChannels(map[string]string{"channel_1": "channel_1"}))
+	assert.Nil(t, memoryStore.SaveStandupConfig(standupConfig))
+
+	getPosts := func() []*model.Post {
+		var posts []*model.Post
+		for _, call := range mockAPI.Calls {
+			if call.Method == "CreatePost" {
+				posts = append(posts, call.Arguments.Get(0).(*model.Post))
+			}
+		}
+
+		return posts
+	}
+
+	saveStandup := func(userID, task string) {
+		userStandup := &standup.UserStandup{
+			UserID:    userID,
+			ChannelID: "channel_1",
+			Standup:   map[string]*[]string{"section 1": {task}},
+		}
+		assert.Nil(t, standup.SaveUserStandup(userStandup))
+		assert.Nil(t, PostLateStandup(userStandup))
+	}
+
+	// standups submitted before the report are part of the report
+	saveStandup("user_id_1", "task 1")
+	assert.Empty(t, getPosts())
+
+	clock.Set(time.Date(2020, 1, 6, 11, 5, 0, 0, location))
+	assert.Nil(t, SendNotificationsAndReports())
+	assert.Equal(t, 1, len(getPosts()))
+
+	status, err := memoryStore.GetNotificationStatus("channel_1", "", "20200106")
+	assert.Nil(t, err)
+	assert.Equal(t, "post_id", status.ReportPostID)
+
+	clock.Set(time.Date(2020, 1, 6, 11, 30, 0, 0, location))
+	saveStandup("user_id_2", "task 2")
+	posts := getPosts()
+	assert.Equal(t, 2, len(posts))
+	assert.Equal(t, "post_id", posts[1].RootId)
+	assert.Equal(t, "channel_1", posts[1].ChannelId)
+	assert.Equal(t, "#### :alarm_clock: Late update from ![User Avatar](/api/v4/users/user_id_2/image =20x20) Jane\n\n##### section 1\n1. task 2\n\n", posts[1].Message)
+
+	// next day's standup isn't late until its report is posted
+	clock.Set(time.Date(2020, 1, 7, 10, 30, 0, 0, location))
+	saveStandup("user_id_2", "task 3")
+	assert.Equal(t, 2, len(getPosts()))
+}
+
+func TestPostLateStandup_UpdateReportPost(t *testing.T) {
+	defer TearDown()
+	mockAPI := setUp()
+	baseMock(mockAPI)
+	mockAPI.On("CreatePost", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{Id: "post_id"}, nil)
+	mockAPI.On("GetPost", "post_id").Return(&model.Post{Id: "post_id", ChannelId: "channel_1", Message: delayedReportNotice + "report"}, nil)
+	mockAPI.On("UpdatePost", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{Id: "post_id"}, nil)
+	mockAPI.On("GetUser", "user_id_1").Return(&model.User{Username: "john", FirstName: "John"}, nil)
+	mockAPI.On("GetUser", "user_id_2").Return(&model.User{Username: "jane", FirstName: "Jane"}, nil)
+
+	memoryStore := standup.NewMemoryStore()
+	standup.SetStore(memoryStore)
+	defer standup.SetStore(&standup.KVStore{})
+
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	clock := otime.NewFakeClock(time.Date(2020, 1, 6, 11, 30, 0, 0, location))
+	otime.SetClock(clock)
+	defer otime.SetClock(otime.SystemClock)
+
+	windowOpenTime, _ := otime.Parse("10:00")
+	windowCloseTime, _ := otime.Parse("11:00")
+
+	standupConfig := &standup.Config{
+		ChannelID:        "channel_1",
+		WindowOpenTime:   windowOpenTime,
+		WindowCloseTime:  windowCloseTime,
+		Enabled:          true,
+		Members:          []string{"user_id_1", "user_id_2"},
+		ReportFormat:     config.ReportFormatUserAggregated,
+		Sections:         []string{"section 1"},
+		Timezone:         "Asia/Kolkata",
+		RRuleString:      "FREQ=DAILY;INTERVAL=1",
+		StartDate:        time.Date(2020, 1, 1, 0, 0, 0, 0, location),
+		UpdateReportPost: true,
+	}
+	assert.Nil(t, standupConfig.PreSave())
+	assert.Nil(t, memoryStore.SaveStandupConfig(standupConfig))
+	assert.Nil(t, memoryStore.SetNotificationStatus("channel_1", "", "20200106", &ChannelNotificationStatus{
+		StandupReportSent: true,
+		ReportPostID:      "post_id",
+	}))
+
+	userStandup := &standup.UserStandup{
+		UserID:    "user_id_2",
+		ChannelID: "channel_1",
+		Standup:   map[string]*[]string{"section 1": {"task 1"}},
+	}
+	assert.Nil(t, standup.SaveUserStandup(userStandup))
+	assert.Nil(t, PostLateStandup(userStandup))
+
+	mockAPI.AssertNotCalled(t, "CreatePost", mock.Anything)
+	mockAPI.AssertNumberOfCalls(t, "UpdatePost", 1)
+
+	post := mockAPI.Calls[len(mockAPI.Calls)-1].Arguments.Get(0).(*model.Post)
+	assert.Equal(t, "post_id", post.Id)
+	assert.Equal(
+		t,
+		delayedReportNotice+"#### Standup Report for *6 Jan 2020*\n\n"+
+			"@john has not submitted their standup\n\n"+
+			"#### ![User Avatar](/api/v4/users/user_id_2/image =20x20) Jane\n\n##### section 1\n1. task 1"+
+			"\n\n---\n:pencil2: _Last updated on 6 Jan 2020 11:30 IST_",
+		post.Message,
+	)
+}
+
+func TestSendStandupReport_ReportDestinations(t *testing.T) {
+	defer TearDown()
+	mockAPI := setUp()
+	baseMock(mockAPI)
+	isChannel := func(channelID string) interface{} {
+		return mock.MatchedBy(func(post *model.Post) bool { return post.ChannelId == channelID })
+	}
+	mockAPI.On("CreatePost", isChannel("channel_1")).Return(&model.Post{Id: "post_id"}, nil)
+	mockAPI.On("CreatePost", isChannel("channel_2")).Return(&model.Post{Id: "post_id_2"}, nil)
+	mockAPI.On("CreatePost", isChannel("channel_3")).Return(nil, model.NewAppError("", "", nil, "no permission", http.StatusForbidden))
+	mockAPI.On("CreatePost", isChannel("dm_channel_1")).Return(&model.Post{Id: "post_id_3"}, nil)
+	mockAPI.On("GetChannel", "channel_1").Return(&model.Channel{Id: "channel_1", Name: "standup"}, nil)
+	mockAPI.On("GetDirectChannel", "user_id_3", mock.Anything).Return(&model.Channel{Id: "dm_channel_1"}, nil)
+	mockAPI.On("GetDirectChannel", "user_id_4", mock.Anything).Return(nil, model.NewAppError("", "", nil, "user not found", http.StatusNotFound))
+	mockAPI.On("GetChannelMember", "channel_1", "user_id_3").Return(&model.ChannelMember{}, nil)
+	mockAPI.On("GetChannelMember", "channel_1", "user_id_4").Return(&model.ChannelMember{}, nil)
+	mockAPI.On("GetChannelMember", "channel_1", "user_id_5").Return(nil, model.NewAppError("", "", nil, "not found", http.StatusNotFound))
+	mockAPI.On("SendEphemeralPost", "user_id_1", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{})
+	mockAPI.On("GetUser", "user_id_1").Return(&model.User{Username: "john", FirstName: "John"}, nil)
+
+	memoryStore := standup.NewMemoryStore()
+	standup.SetStore(memoryStore)
+	defer standup.SetStore(&standup.KVStore{})
+
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	clock := otime.NewFakeClock(time.Date(2020, 1, 6, 11, 5, 0, 0, location))
+	otime.SetClock(clock)
+	defer otime.SetClock(otime.SystemClock)
+
+	windowOpenTime, _ := otime.Parse("10:00")
+	windowCloseTime, _ := otime.Parse("11:00")
+
+	standupConfig := &standup.Config{
+		ChannelID:                  "channel_1",
+		WindowOpenTime:             windowOpenTime,
+		WindowCloseTime:            windowCloseTime,
+		Enabled:                    true,
+		Members:                    []string{"user_id_1"},
+		ReportFormat:               config.ReportFormatUserAggregated,
+		Sections:                   []string{"section 1"},
+		Timezone:                   "Asia/Kolkata",
+		WindowOpenReminderEnabled:  false,
+		WindowCloseReminderEnabled: false,
+		RRuleString:                "FREQ=DAILY;INTERVAL=1",
+		StartDate:                  time.Date(2020, 1, 1, 0, 0, 0, 0, location),
+		ReportChannels:             []string{"channel_2", "channel_3"},
+		ReportUsers:                []string{"user_id_3", "user_id_4", "user_id_5"},
+	}
+	assert.Nil(t, standupConfig.PreSave())
+	assert.Nil(t, standupConfig.IsValid())
+	assert.Nil(t, memoryStore.SaveStandupConfig(standupConfig))
+	assert.Nil(t, memoryStore.SaveUserStandup("20200106", &standup.UserStandup{
+		UserID:    "user_id_1",
+		ChannelID: "channel_1",
+		Standup:   map[string]*[]string{"section 1": {"task 1"}},
+	}))
+
+	// manually posted reports aren't copied
+	assert.Nil(t, SendStandupReport([]string{"channel_1"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "user_id_1", false))
+	mockAPI.AssertNumberOfCalls(t, "CreatePost", 1)
+
+	// failing destinations don't fail the report
+	assert.Nil(t, SendStandupReport([]string{"channel_1"}, "", otime.Now("Asia/Kolkata"), ReportVisibilityPublic, "", true))
+
+	var posts []*model.Post
+	for _, call := range mockAPI.Calls {
+		if call.Method == "CreatePost" {
+			posts = append(posts, call.Arguments.Get(0).(*model.Post))
+		}
+	}
+
+	assert.Equal(t, 6, len(posts))
+	assert.Equal(t, "channel_1", posts[1].ChannelId)
+	for i, channelID := range []string{"channel_2", "channel_3", "dm_channel_1"} {
+		assert.Equal(t, channelID, posts[i+2].ChannelId)
+		assert.Equal(t, "_Standup report of ~standup_\n\n"+posts[1].Message, posts[i+2].Message)
+	}
+
+	status, err := memoryStore.GetNotificationStatus("channel_1", "", "20200106")
+	assert.Nil(t, err)
+	assert.True(t, status.StandupReportSent)
+	assert.Equal(t, "post_id", status.ReportPostID)
+
+	// without a user to tell, failures are posted in the standup channel
+	assert.Equal(t, "channel_1", posts[5].ChannelId)
+	assert.Equal(
+		t,
+		"Standup report couldn't be sent to some report destinations -\n"+
+			"* channel channel_3: : , no permission\n"+
+			"* user user_id_4: : , user not found\n"+
+			"* user user_id_5: user isn't a member of the standup channel",
+		posts[5].Message,
+	)
+	mockAPI.AssertNotCalled(t, "SendEphemeralPost", mock.Anything, mock.Anything)
+}
+
+func TestSendWindowCloseNotification_NoPendingMembers(t *testing.T) {
+	defer TearDown()
+	mockAPI := setUp()
+	baseMock(mockAPI)
+	mockAPI.On("CreatePost", mock.AnythingOfType(model.Post{}.Type)).Return(&model.Post{Id: "post_id"}, nil)
+	mockAPI.On("GetUser", "user_id_2").Return(&model.User{Username: "jane", FirstName: "Jane"}, nil)
+
+	memoryStore := standup.NewMemoryStore()
+	standup.SetStore(memoryStore)
+	defer standup.SetStore(&standup.KVStore{})
+
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	otime.SetClock(otime.NewFakeClock(time.Date(2020, 1, 6, 10, 50, 0, 0, location)))
+	defer otime.SetClock(otime.SystemClock)
+
+	windowOpenTime, _ := otime.Parse("10:00")
+	windowCloseTime, _ := otime.Parse("11:00")
+
+	for i, userID := range []string{"user_id_1", "user_id_2"} {
+		standupConfig := &standup.Config{
+			ChannelID:       fmt.Sprintf("channel_%d", i+1),
+			WindowOpenTime:  windowOpenTime,
+			WindowCloseTime: windowCloseTime,
+			Enabled:         true,
+			Members:         []string{userID},
+			ReportFormat:    config.ReportFormatUserAggregated,
+			Sections:        []string{"section 1"},
+			Timezone:        "Asia/Kolkata",
+			RRuleString:     "FREQ=DAILY;INTERVAL=1",
+			StartDate:       time.Date(2020, 1, 1, 0, 0, 0, 0, location),
+		}
+		assert.Nil(t, standupConfig.PreSave())
+		assert.Nil(t, memoryStore.SaveStandupConfig(standupConfig))
+	}
+
+	// everyone in the first standup has filled their standup
+	assert.Nil(t, memoryStore.SaveUserStandup("20200106", &standup.UserStandup{
+		UserID:    "user_id_1",
+		ChannelID: "channel_1",
+		Standup:   map[string]*[]string{"section 1": {"task 1"}},
+	}))
+
+	assert.Nil(t, sendWindowCloseNotification([]channelStandup{
+		{ChannelID: "channel_1", ReminderStep: 1},
+		{ChannelID: "channel_2", ReminderStep: 1},
+	}))
+
+	// the standup without pending members doesn't stop reminding others
+	mockAPI.AssertNumberOfCalls(t, "CreatePost", 1)
+	mockAPI.AssertCalled(t, "CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+		return post.ChannelId == "channel_2" && strings.HasPrefix(post.Message, "@jane - ")
+	}))
+
+	for _, channelID := range []string{"channel_1", "channel_2"} {
+		status, err := memoryStore.GetNotificationStatus(channelID, "", "20200106")
+		assert.Nil(t, err)
+		assert.Equal(t, []int{1}, status.RemindersSent, channelID)
+	}
+}
@@ -0,0 +1,70 @@
+package notification
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/logger"
+	"github.com/standup-raven/standup-raven/server/otime"
+	"github.com/standup-raven/standup-raven/server/standup"
+)
+
+// customReminderMessage renders the reminder message template of standup for the specified
+// members yet to fill their standup of the date. The message is empty if the template is empty
+// or fails to render, in which case the built-in reminder message is to be sent instead.
+func customReminderMessage(standupConfig *standup.Config, messageTemplate string, date otime.OTime, userIDs []string) string {
+	if messageTemplate == "" {
+		return ""
+	}
+
+	data, err := reminderMessageData(standupConfig, date, userIDs)
+	if err != nil {
+		logger.Error("Couldn't fetch reminder message data, sending built-in reminder message instead", err, map[string]interface{}{"channelID": standupConfig.ChannelID})
+		return ""
+	}
+
+	message, err := standup.RenderReminderMessage(messageTemplate, data)
+	if err != nil {
+		logger.Error("Couldn't render reminder message, sending built-in reminder message instead", err, map[string]interface{}{"channelID": standupConfig.ChannelID})
+		return ""
+	}
+
+	return message
+}
+
+func reminderMessageData(standupConfig *standup.Config, date otime.OTime, userIDs []string) (standup.ReminderMessageData, error) {
+	data := standup.ReminderMessageData{
+		WindowCloseTime: standupConfig.WindowCloseTime.GetTimeString(),
+		MemberCount:     len(standupConfig.Members),
+	}
+
+	channel, appErr := config.Mattermost.GetChannel(standupConfig.ChannelID)
+	if appErr != nil {
+		return data, errors.New(appErr.Error())
+	}
+	data.ChannelName = channel.Name
+
+	for _, userID := range userIDs {
+		user, appErr := config.Mattermost.GetUser(userID)
+		if appErr != nil {
+			return data, errors.New(appErr.Error())
+		}
+
+		data.PendingMembers = append(data.PendingMembers, user.Username)
+	}
+	sort.Strings(data.PendingMembers)
+
+	for _, userID := range standupConfig.Members {
+		userStandup, err := standup.GetUserStandup(userID, standupConfig.ChannelID, standupConfig.StandupID, date)
+		if err != nil {
+			return data, err
+		}
+
+		if userStandup != nil {
+			data.SubmissionCount++
+		}
+	}
+
+	return data, nil
+}
@@ -0,0 +1,116 @@
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/logger"
+	"github.com/standup-raven/standup-raven/server/standup"
+)
+
+// reportCopyFailure is an additional report destination a copy of standup report couldn't be sent to.
+type reportCopyFailure struct {
+	// "channel <channel ID>" or "user <user ID>"
+	destination string
+	err         error
+}
+
+// sendReportCopies posts a copy of the standup report in each additional report channel of
+// standup and sends it to each additional report user as a direct message from the bot.
+// Failing to send to a destination doesn't stop sending to others. Every failure is logged
+// and listed to the user the report was sent on behalf of as an ephemeral post or,
+// if there is no such user, posted in the standup channel.
+func sendReportCopies(standupConfig *standup.Config, report *model.Post, userID string) {
+	if len(standupConfig.ReportChannels) == 0 && len(standupConfig.ReportUsers) == 0 {
+		return
+	}
+
+	failures := postReportCopies(standupConfig, report)
+	if len(failures) == 0 {
+		return
+	}
+
+	lines := make([]string, 0, len(failures))
+	for _, failure := range failures {
+		logger.Error("Couldn't send a copy of standup report", failure.err, map[string]interface{}{"channelID": standupConfig.ChannelID, "destination": failure.destination})
+		lines = append(lines, fmt.Sprintf("* %s: %s", failure.destination, failure.err.Error()))
+	}
+
+	post := &model.Post{
+		ChannelId: standupConfig.ChannelID,
+		UserId:    config.GetConfig().BotUserID,
+		Message:   "Standup report couldn't be sent to some report destinations -\n" + strings.Join(lines, "\n"),
+	}
+
+	if userID != "" {
+		config.Mattermost.SendEphemeralPost(userID, post)
+		return
+	}
+
+	if _, appErr := config.Mattermost.CreatePost(post); appErr != nil {
+		logger.Error("Couldn't post report destination failures in standup channel", appErr, map[string]interface{}{"channelID": standupConfig.ChannelID})
+	}
+}
+
+func postReportCopies(standupConfig *standup.Config, report *model.Post) []*reportCopyFailure {
+	var failures []*reportCopyFailure
+
+	channel, appErr := config.Mattermost.GetChannel(standupConfig.ChannelID)
+	if appErr != nil {
+		err := errors.New("couldn't fetch standup channel: " + appErr.Error())
+		for _, channelID := range standupConfig.ReportChannels {
+			failures = append(failures, &reportCopyFailure{destination: "channel " + channelID, err: err})
+		}
+
+		for _, userID := range standupConfig.ReportUsers {
+			failures = append(failures, &reportCopyFailure{destination: "user " + userID, err: err})
+		}
+
+		return failures
+	}
+
+	message := fmt.Sprintf("_Standup report of ~%s_\n\n", channel.Name) + report.Message
+	botUserID := config.GetConfig().BotUserID
+
+	for _, channelID := range standupConfig.ReportChannels {
+		if err := createReportCopy(channelID, botUserID, message); err != nil {
+			failures = append(failures, &reportCopyFailure{destination: "channel " + channelID, err: err})
+		}
+	}
+
+	for _, userID := range standupConfig.ReportUsers {
+		// users who have left the standup channel shouldn't keep receiving its reports
+		if _, appErr := config.Mattermost.GetChannelMember(standupConfig.ChannelID, userID); appErr != nil {
+			failures = append(failures, &reportCopyFailure{destination: "user " + userID, err: errors.New("user isn't a member of the standup channel")})
+			continue
+		}
+
+		directChannel, appErr := config.Mattermost.GetDirectChannel(userID, botUserID)
+		if appErr != nil {
+			failures = append(failures, &reportCopyFailure{destination: "user " + userID, err: errors.New(appErr.Error())})
+			continue
+		}
+
+		if err := createReportCopy(directChannel.Id, botUserID, message); err != nil {
+			failures = append(failures, &reportCopyFailure{destination: "user " + userID, err: err})
+		}
+	}
+
+	return failures
+}
+
+func createReportCopy(channelID, botUserID, message string) error {
+	if _, appErr := config.Mattermost.CreatePost(&model.Post{
+		ChannelId: channelID,
+		UserId:    botUserID,
+		Message:   message,
+	}); appErr != nil {
+		return errors.New(appErr.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/standup-raven/standup-raven/server/otime"
+)
+
+// Simulate runs the standup cycle on simulated time. Starting at the current time of the clock,
+// it runs SendNotificationsAndReports once every step until the specified time, the way
+// the scheduler would, advancing the clock in between. This allows replaying days of
+// standup schedule, such as around DST transitions, in no time.
+//
+// beforeRun, if not nil, is called with the simulated time before every run,
+// for example to submit standups at a given time. An error from it or from
+// a run stops the simulation.
+//
+// The clock is used for current time during the simulation only.
+func Simulate(clock *otime.FakeClock, until time.Time, step time.Duration, beforeRun func(now time.Time) error) error {
+	previousClock := otime.GetClock()
+	otime.SetClock(clock)
+	defer otime.SetClock(previousClock)
+
+	for ; clock.Now().Before(until); clock.Advance(step) {
+		if beforeRun != nil {
+			if err := beforeRun(clock.Now()); err != nil {
+				return err
+			}
+		}
+
+		if err := SendNotificationsAndReports(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
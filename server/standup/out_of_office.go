@@ -0,0 +1,99 @@
+package standup
+
+import (
+	"errors"
+	"time"
+
+	"github.com/standup-raven/standup-raven/server/otime"
+)
+
+// OutOfOffice is a period a user is out of office for. Users out of office on a standup date
+// aren't reminded to fill their standup and are listed separately in standup report.
+// It applies to all standups the user is a member of.
+type OutOfOffice struct {
+	// first and last dates of the period, both inclusive, in YYYY-MM-DD format
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// Includes checks if the calendar date of the specified time falls in the period.
+// A nil period includes no date.
+func (o *OutOfOffice) Includes(date otime.OTime) bool {
+	if o == nil {
+		return false
+	}
+
+	dateString := date.Format(otime.LayoutISODate)
+	return dateString >= o.From && dateString <= o.To
+}
+
+// GetOutOfOffice returns out of office periods of those of the specified users
+// who have one, keyed by user ID. Periods may have already ended.
+func GetOutOfOffice(userIDs []string) (map[string]*OutOfOffice, error) {
+	outOfOffice := map[string]*OutOfOffice{}
+	for _, userID := range userIDs {
+		period, err := store.GetUserOutOfOffice(userID)
+		if err != nil {
+			return nil, err
+		}
+
+		if period != nil {
+			outOfOffice[userID] = period
+		}
+	}
+
+	return outOfOffice, nil
+}
+
+// GetOutOfOfficeUsers returns those of the specified users who are out of office
+// on the calendar date of the specified time.
+func GetOutOfOfficeUsers(userIDs []string, date otime.OTime) (map[string]bool, error) {
+	outOfOffice, err := GetOutOfOffice(userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	users := map[string]bool{}
+	for userID, period := range outOfOffice {
+		if period.Includes(date) {
+			users[userID] = true
+		}
+	}
+
+	return users, nil
+}
+
+// SetUserOutOfOffice marks the user out of office from and to the calendar dates of
+// the specified times, replacing any earlier period of the user.
+func SetUserOutOfOffice(userID string, from, to time.Time) error {
+	if to.Before(from) {
+		return errors.New("out of office period cannot end before it starts")
+	}
+
+	return store.SetUserOutOfOffice(userID, &OutOfOffice{
+		From: from.Format(otime.LayoutISODate),
+		To:   to.Format(otime.LayoutISODate),
+	})
+}
+
+// ClearUserOutOfOffice removes out of office period of the user
+// and reports whether the user had one which hasn't ended yet.
+func ClearUserOutOfOffice(userID string) (bool, error) {
+	period, err := store.GetUserOutOfOffice(userID)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := store.DeleteUserOutOfOffice(userID); err != nil {
+		return false, err
+	}
+
+	return period != nil && !period.ended(), nil
+}
+
+// ended checks if the period ended before today in every timezone.
+func (o *OutOfOffice) ended() bool {
+	// a day before today in UTC is still today in some timezones
+	yesterday := otime.Now("UTC").AddDate(0, 0, -1).Format(otime.LayoutISODate)
+	return o.To < yesterday
+}
@@ -0,0 +1,51 @@
+package standup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/standup-raven/standup-raven/server/otime"
+)
+
+func TestSetUserOutOfOffice(t *testing.T) {
+	defer TearDown()
+	SetStore(NewMemoryStore())
+	defer SetStore(&KVStore{})
+
+	otime.SetClock(otime.NewFakeClock(time.Date(2020, 1, 6, 10, 0, 0, 0, time.UTC)))
+	defer otime.SetClock(otime.SystemClock)
+
+	assert.Nil(t, SetUserOutOfOffice("user_id_1", time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 10, 0, 0, 0, 0, time.UTC)))
+	assert.NotNil(t, SetUserOutOfOffice("user_id_2", time.Date(2020, 1, 10, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)))
+
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	for day, expected := range map[int]bool{5: false, 6: true, 10: true, 11: false} {
+		users, err := GetOutOfOfficeUsers([]string{"user_id_1", "user_id_2"}, otime.OTime{Time: time.Date(2020, 1, day, 0, 0, 0, 0, location)})
+		assert.Nil(t, err)
+		assert.Equal(t, expected, users["user_id_1"], day)
+		assert.False(t, users["user_id_2"])
+	}
+
+	// ended periods aren't reported as cleared
+	assert.Nil(t, store.SetUserOutOfOffice("user_id_2", &OutOfOffice{From: "2020-01-01", To: "2020-01-04"}))
+	outOfOffice, err := GetOutOfOffice([]string{"user_id_1", "user_id_2", "user_id_3"})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]*OutOfOffice{
+		"user_id_1": {From: "2020-01-06", To: "2020-01-10"},
+		"user_id_2": {From: "2020-01-01", To: "2020-01-04"},
+	}, outOfOffice)
+
+	cleared, err := ClearUserOutOfOffice("user_id_2")
+	assert.Nil(t, err)
+	assert.False(t, cleared)
+
+	cleared, err = ClearUserOutOfOffice("user_id_1")
+	assert.Nil(t, err)
+	assert.True(t, cleared)
+
+	cleared, err = ClearUserOutOfOffice("user_id_1")
+	assert.Nil(t, err)
+	assert.False(t, cleared)
+}
@@ -0,0 +1,123 @@
+package standup
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/standup-raven/standup-raven/server/config"
+)
+
+const (
+	// window times reminders are relative to
+	ReminderAnchorOpen  = "open"
+	ReminderAnchorClose = "close"
+
+	remindersMaxLength = 10
+)
+
+// Reminder is a reminder sent during standup window, the specified number of minutes
+// after window open time or before window close time.
+type Reminder struct {
+	Anchor  string `json:"anchor"`
+	Minutes int    `json:"minutes"`
+}
+
+// IsWindowOpen checks if this is the reminder sent as window opens, asking everyone
+// to start filling their standup. All other reminders mention members
+// who are yet to fill their standup.
+func (r Reminder) IsWindowOpen() bool {
+	return r.Anchor == ReminderAnchorOpen && r.Minutes == 0
+}
+
+// Time returns the time reminder is sent at in the specified standup window.
+func (r Reminder) Time(windowOpen, windowClose time.Time) time.Time {
+	if r.Anchor == ReminderAnchorClose {
+		return windowClose.Add(-time.Duration(r.Minutes) * time.Minute)
+	}
+
+	return windowOpen.Add(time.Duration(r.Minutes) * time.Minute)
+}
+
+func (r Reminder) String() string {
+	if r.Anchor == ReminderAnchorClose {
+		return fmt.Sprintf("%s-%d", r.Anchor, r.Minutes)
+	}
+
+	return fmt.Sprintf("%s+%d", r.Anchor, r.Minutes)
+}
+
+// ReminderSchedule returns reminders of standup ordered by the time they are sent at.
+// Without reminders configured, a reminder is sent as window opens and another once
+// config.WindowCloseNotificationDurationPercentage of the window has passed.
+// Notification status tracks sent reminders by their index in this schedule.
+func (sc *Config) ReminderSchedule() []Reminder {
+	if len(sc.Reminders) == 0 {
+		windowDuration := sc.windowDuration().Minutes()
+		return []Reminder{
+			{Anchor: ReminderAnchorOpen, Minutes: 0},
+			{Anchor: ReminderAnchorClose, Minutes: int(math.Round(windowDuration * (1 - config.WindowCloseNotificationDurationPercentage)))},
+		}
+	}
+
+	reminders := append([]Reminder{}, sc.Reminders...)
+	sort.SliceStable(reminders, func(i, j int) bool {
+		return sc.reminderOffset(reminders[i]) < sc.reminderOffset(reminders[j])
+	})
+
+	return reminders
+}
+
+// validateReminders checks that all reminders are sent within standup window
+// and no two reminders are sent at the same time.
+func (sc *Config) validateReminders() error {
+	if len(sc.Reminders) > remindersMaxLength {
+		return fmt.Errorf("too many reminders. At most %d reminders are allowed", remindersMaxLength)
+	}
+
+	windowDuration := int(sc.windowDuration().Minutes())
+	offsets := map[time.Duration]bool{}
+
+	for _, reminder := range sc.Reminders {
+		if reminder.Anchor != ReminderAnchorOpen && reminder.Anchor != ReminderAnchorClose {
+			return fmt.Errorf("invalid reminder \"%s\". Reminders can only be relative to window \"%s\" or \"%s\" time", reminder, ReminderAnchorOpen, ReminderAnchorClose)
+		}
+
+		if reminder.Minutes < 0 || reminder.Minutes >= windowDuration {
+			return fmt.Errorf("invalid reminder \"%s\". Reminders must be sent during standup window", reminder)
+		}
+
+		if reminder.Anchor == ReminderAnchorClose && reminder.Minutes == 0 {
+			return fmt.Errorf("invalid reminder \"%s\". Reminders cannot be sent at window close time", reminder)
+		}
+
+		offset := sc.reminderOffset(reminder)
+		if offsets[offset] {
+			return errors.New("Duplicate reminders are not allowed. Reminder '" + reminder.String() + "' is sent at the same time as another reminder")
+		}
+		offsets[offset] = true
+	}
+
+	return nil
+}
+
+// reminderOffset returns the duration after window open time the reminder is sent at.
+func (sc *Config) reminderOffset(reminder Reminder) time.Duration {
+	if reminder.Anchor == ReminderAnchorClose {
+		return sc.windowDuration() - time.Duration(reminder.Minutes)*time.Minute
+	}
+
+	return time.Duration(reminder.Minutes) * time.Minute
+}
+
+// windowDuration returns the duration of standup window, ignoring daylight saving time changes.
+func (sc *Config) windowDuration() time.Duration {
+	seconds := secondOfDay(sc.WindowCloseTime.Time) - secondOfDay(sc.WindowOpenTime.Time)
+	if seconds <= 0 {
+		seconds += 24 * 60 * 60
+	}
+
+	return time.Duration(seconds) * time.Second
+}
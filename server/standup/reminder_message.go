@@ -0,0 +1,90 @@
+package standup
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// reminderMessageMaxLength is the maximum length of a reminder message template.
+const reminderMessageMaxLength = 2000
+
+// ReminderMessageData is what reminder message templates are rendered with.
+type ReminderMessageData struct {
+	// name of standup channel, which can be mentioned as ~{{.ChannelName}}
+	ChannelName string
+
+	// window close time in "15:04" format
+	WindowCloseTime string
+
+	// usernames of members being reminded, who are yet to fill their standup
+	PendingMembers []string
+
+	// number of members who have filled their standup and number of standup members
+	SubmissionCount int
+	MemberCount     int
+}
+
+// reminderMessageFuncs are the functions available in reminder message templates
+// in addition to the text/template built-in functions.
+var reminderMessageFuncs = template.FuncMap{
+	"join": strings.Join,
+}
+
+// sampleReminderMessageData is used for validating reminder message templates.
+var sampleReminderMessageData = ReminderMessageData{
+	ChannelName:     "channel",
+	WindowCloseTime: "11:00",
+	PendingMembers:  []string{"john.doe", "jane.doe"},
+	SubmissionCount: 1,
+	MemberCount:     3,
+}
+
+// RenderReminderMessage renders the reminder message template with the specified data.
+func RenderReminderMessage(messageTemplate string, data ReminderMessageData) (string, error) {
+	t, err := template.New("reminder").Funcs(reminderMessageFuncs).Parse(messageTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	var message bytes.Buffer
+	if err := t.Execute(&message, data); err != nil {
+		return "", err
+	}
+
+	if strings.TrimSpace(message.String()) == "" {
+		return "", errors.New("template renders an empty message")
+	}
+
+	return message.String(), nil
+}
+
+// validateReminderMessages checks that reminder message templates of standup,
+// if specified, render a message.
+func (sc *Config) validateReminderMessages() error {
+	messageTemplates := []struct {
+		name     string
+		template string
+	}{
+		{name: "window open", template: sc.WindowOpenMessage},
+		{name: "window close", template: sc.WindowCloseMessage},
+	}
+
+	for _, messageTemplate := range messageTemplates {
+		if messageTemplate.template == "" {
+			continue
+		}
+
+		if len(messageTemplate.template) > reminderMessageMaxLength {
+			return fmt.Errorf("%s reminder message is too long. It can be at most %d characters long", messageTemplate.name, reminderMessageMaxLength)
+		}
+
+		if _, err := RenderReminderMessage(messageTemplate.template, sampleReminderMessageData); err != nil {
+			return fmt.Errorf("invalid %s reminder message: %s", messageTemplate.name, err.Error())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package standup
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/standup-raven/standup-raven/server/otime"
+)
+
+func TestConfig_ReminderSchedule(t *testing.T) {
+	standupConfig := scheduleTestConfig(t)
+
+	// reminder once 80% of the window has passed by default
+	assert.Equal(t, []Reminder{
+		{Anchor: ReminderAnchorOpen, Minutes: 0},
+		{Anchor: ReminderAnchorClose, Minutes: 12},
+	}, standupConfig.ReminderSchedule())
+
+	standupConfig.Reminders = []Reminder{
+		{Anchor: ReminderAnchorClose, Minutes: 5},
+		{Anchor: ReminderAnchorOpen, Minutes: 30},
+		{Anchor: ReminderAnchorClose, Minutes: 15},
+	}
+	assert.Nil(t, standupConfig.IsValid())
+	assert.Equal(t, []Reminder{
+		{Anchor: ReminderAnchorOpen, Minutes: 30},
+		{Anchor: ReminderAnchorClose, Minutes: 15},
+		{Anchor: ReminderAnchorClose, Minutes: 5},
+	}, standupConfig.ReminderSchedule())
+
+	windowOpen, windowClose := standupConfig.Window(standupConfig.StartDate)
+	assert.Equal(t, "10:30", standupConfig.ReminderSchedule()[0].Time(windowOpen, windowClose).Format("15:04"))
+	assert.Equal(t, "10:55", standupConfig.ReminderSchedule()[2].Time(windowOpen, windowClose).Format("15:04"))
+}
+
+func TestConfig_IsValid_Reminders(t *testing.T) {
+	standupConfig := scheduleTestConfig(t)
+
+	standupConfig.Reminders = []Reminder{{Anchor: "start", Minutes: 10}}
+	assert.NotNil(t, standupConfig.IsValid())
+
+	standupConfig.Reminders = []Reminder{{Anchor: ReminderAnchorOpen, Minutes: -5}}
+	assert.NotNil(t, standupConfig.IsValid())
+
+	standupConfig.Reminders = []Reminder{{Anchor: ReminderAnchorOpen, Minutes: 60}}
+	assert.NotNil(t, standupConfig.IsValid(), "reminder at window close time")
+
+	standupConfig.Reminders = []Reminder{{Anchor: ReminderAnchorClose, Minutes: 0}}
+	assert.NotNil(t, standupConfig.IsValid(), "reminder at window close time")
+
+	standupConfig.Reminders = []Reminder{{Anchor: ReminderAnchorOpen, Minutes: 45}, {Anchor: ReminderAnchorClose, Minutes: 15}}
+	assert.NotNil(t, standupConfig.IsValid(), "both reminders are sent at 10:45")
+
+	standupConfig.Reminders = []Reminder{}
+	for i := 0; i <= remindersMaxLength; i++ {
+		standupConfig.Reminders = append(standupConfig.Reminders, Reminder{Anchor: ReminderAnchorOpen, Minutes: i})
+	}
+	assert.NotNil(t, standupConfig.IsValid())
+
+	// window crossing midnight
+	standupConfig.WindowOpenTime, _ = otime.Parse("22:00")
+	standupConfig.WindowCloseTime, _ = otime.Parse("02:00")
+	standupConfig.Reminders = []Reminder{{Anchor: ReminderAnchorOpen, Minutes: 180}, {Anchor: ReminderAnchorClose, Minutes: 30}}
+	assert.Nil(t, standupConfig.IsValid())
+}
+
+func TestConfig_IsValid_ReminderMessages(t *testing.T) {
+	standupConfig := scheduleTestConfig(t)
+
+	standupConfig.WindowOpenMessage = "Standup in ~{{.ChannelName}} is open until {{.WindowCloseTime}}!"
+	standupConfig.WindowCloseMessage = "@{{join .PendingMembers \", @\"}} - {{.SubmissionCount}} of {{.MemberCount}} have filled their standup."
+	assert.Nil(t, standupConfig.IsValid())
+
+	standupConfig.WindowOpenMessage = "{{.ChannelName"
+	assert.NotNil(t, standupConfig.IsValid(), "template doesn't parse")
+
+	standupConfig.WindowOpenMessage = "{{.Channel}}"
+	assert.NotNil(t, standupConfig.IsValid(), "unknown field")
+
+	standupConfig.WindowOpenMessage = "{{if false}}Standup{{end}} "
+	assert.NotNil(t, standupConfig.IsValid(), "empty message")
+
+	standupConfig.WindowOpenMessage = strings.Repeat("a", reminderMessageMaxLength+1)
+	assert.NotNil(t, standupConfig.IsValid())
+}
+
+func TestRenderReminderMessage(t *testing.T) {
+	message, err := RenderReminderMessage("@{{join .PendingMembers \", @\"}} - {{.SubmissionCount}} of {{.MemberCount}} done.", ReminderMessageData{
+		PendingMembers:  []string{"jane", "john"},
+		SubmissionCount: 1,
+		MemberCount:     3,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "@jane, @john - 1 of 3 done.", message)
+
+	_, err = RenderReminderMessage("{{index .PendingMembers 1}}", ReminderMessageData{PendingMembers: []string{"jane"}})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,202 @@
+package standup
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/thoas/go-funk"
+
+	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/util"
+)
+
+const (
+	// reportTemplateMaxLength is the maximum length of a custom report template.
+	reportTemplateMaxLength = 5000
+
+	// reportDestinationsMaxLength is the maximum number of additional
+	// report channels, and of additional report users.
+	reportDestinationsMaxLength = 10
+)
+
+// Report is a standup report of a date, which custom report templates are rendered with.
+// Members are listed by their username.
+type Report struct {
+	Title    string
+	Date     time.Time
+	Sections []string
+
+	// members who submitted their standup, sorted by display name ignoring case
+	Members []*ReportMember
+
+	// members who neither submitted their standup, nor were out of office or skipped it
+	MembersNoStandup   []string
+	MembersOutOfOffice []string
+	MembersSkipped     []string
+
+	Stats ReportStats
+}
+
+// ReportMember is the standup submitted by a member.
+type ReportMember struct {
+	UserID      string
+	Username    string
+	DisplayName string
+
+	// all standup sections, in the order of standup sections
+	Sections []*ReportSection
+}
+
+// ReportSection lists the tasks a member filled in a standup section.
+type ReportSection struct {
+	Title string
+	Tasks []string
+}
+
+// ReportStats are counts of report members and tasks.
+type ReportStats struct {
+	MemberCount      int
+	SubmissionCount  int
+	NoStandupCount   int
+	OutOfOfficeCount int
+	SkippedCount     int
+	TaskCount        int
+}
+
+// reportFuncs are the functions available in report templates
+// in addition to the text/template built-in functions.
+var reportFuncs = template.FuncMap{
+	"join":     strings.Join,
+	"userIcon": util.UserIcon,
+}
+
+// sampleReport is used for validating report templates.
+var sampleReport = &Report{
+	Title:    "Standup Report",
+	Date:     time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC),
+	Sections: []string{"Yesterday", "Today"},
+	Members: []*ReportMember{
+		{
+			UserID:      "user_id",
+			Username:    "john.doe",
+			DisplayName: "John Doe",
+			Sections: []*ReportSection{
+				{Title: "Yesterday", Tasks: []string{"task 1", "task 2"}},
+				{Title: "Today", Tasks: []string{"task 3"}},
+			},
+		},
+	},
+	MembersNoStandup:   []string{"jane.doe"},
+	MembersOutOfOffice: []string{"alice"},
+	MembersSkipped:     []string{"bob"},
+	Stats: ReportStats{
+		MemberCount:      4,
+		SubmissionCount:  1,
+		NoStandupCount:   1,
+		OutOfOfficeCount: 1,
+		SkippedCount:     1,
+		TaskCount:        3,
+	},
+}
+
+// RenderReport renders the report template with the specified report.
+func RenderReport(reportTemplate string, report *Report) (string, error) {
+	t, err := template.New("report").Funcs(reportFuncs).Parse(reportTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	var text bytes.Buffer
+	if err := t.Execute(&text, report); err != nil {
+		return "", err
+	}
+
+	if strings.TrimSpace(text.String()) == "" {
+		return "", errors.New("template renders an empty report")
+	}
+
+	return text.String(), nil
+}
+
+// validateReportTemplate checks that standups with the custom report format
+// have a report template which renders a report.
+func (sc *Config) validateReportTemplate() error {
+	if sc.ReportFormat != config.ReportFormatCustom {
+		return nil
+	}
+
+	if sc.ReportTemplate == "" {
+		return errors.New("report template cannot be empty for custom report format")
+	}
+
+	if len(sc.ReportTemplate) > reportTemplateMaxLength {
+		return fmt.Errorf("report template is too long. It can be at most %d characters long", reportTemplateMaxLength)
+	}
+
+	if _, err := RenderReport(sc.ReportTemplate, sampleReport); err != nil {
+		return fmt.Errorf("invalid report template: %s", err.Error())
+	}
+
+	return nil
+}
+
+// AddReportDestinations adds the channels and users to additional report destinations
+// of standup, skipping those already added.
+func (sc *Config) AddReportDestinations(channelIDs, userIDs []string) error {
+	for _, channelID := range channelIDs {
+		if channelID == sc.ChannelID {
+			return errors.New("standup report is already posted in the standup channel")
+		}
+	}
+
+	sc.ReportChannels = funk.UniqString(append(sc.ReportChannels, channelIDs...))
+	sc.ReportUsers = funk.UniqString(append(sc.ReportUsers, userIDs...))
+	return sc.validateReportDestinations()
+}
+
+// RemoveReportDestinations removes the channels and users from additional report destinations
+// of standup and reports whether all of them were report destinations.
+func (sc *Config) RemoveReportDestinations(channelIDs, userIDs []string) bool {
+	removed := true
+	for _, channelID := range channelIDs {
+		removed = removed && funk.ContainsString(sc.ReportChannels, channelID)
+	}
+
+	for _, userID := range userIDs {
+		removed = removed && funk.ContainsString(sc.ReportUsers, userID)
+	}
+
+	sc.ReportChannels, _ = funk.DifferenceString(sc.ReportChannels, channelIDs)
+	sc.ReportUsers, _ = funk.DifferenceString(sc.ReportUsers, userIDs)
+	return removed
+}
+
+// validateReportDestinations checks that additional report channels and users
+// are few, unique and don't include the standup channel.
+func (sc *Config) validateReportDestinations() error {
+	if len(sc.ReportChannels) > reportDestinationsMaxLength {
+		return fmt.Errorf("too many report channels. At most %d report channels are allowed", reportDestinationsMaxLength)
+	}
+
+	if len(sc.ReportUsers) > reportDestinationsMaxLength {
+		return fmt.Errorf("too many report users. At most %d report users are allowed", reportDestinationsMaxLength)
+	}
+
+	if duplicateChannel, hasDuplicate := util.ContainsDuplicates(&sc.ReportChannels); hasDuplicate {
+		return errors.New("Duplicate report channels are not allowed. Contains duplicate channel '" + duplicateChannel + "'")
+	}
+
+	if duplicateUser, hasDuplicate := util.ContainsDuplicates(&sc.ReportUsers); hasDuplicate {
+		return errors.New("Duplicate report users are not allowed. Contains duplicate user '" + duplicateUser + "'")
+	}
+
+	if funk.ContainsString(sc.ReportChannels, sc.ChannelID) {
+		return errors.New("standup channel cannot be a report channel")
+	}
+
+	return nil
+}
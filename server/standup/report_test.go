@@ -0,0 +1,76 @@
+package standup
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/standup-raven/standup-raven/server/config"
+)
+
+func TestConfig_IsValid_ReportTemplate(t *testing.T) {
+	standupConfig := scheduleTestConfig(t)
+
+	standupConfig.ReportTemplate = "{{.Channel}}"
+	assert.Nil(t, standupConfig.IsValid(), "report template is only used by custom report format")
+
+	standupConfig.ReportFormat = config.ReportFormatCustom
+	standupConfig.ReportTemplate = ""
+	assert.NotNil(t, standupConfig.IsValid(), "report template is required for custom report format")
+
+	standupConfig.ReportTemplate = "{{.Title}} - {{.Date.Format \"2 Jan\"}}\n{{range .Members}}{{.DisplayName}}: {{range .Sections}}{{.Title}} {{join .Tasks \", \"}} {{end}}\n{{end}}"
+	assert.Nil(t, standupConfig.IsValid())
+
+	standupConfig.ReportTemplate = "{{range .Members}}"
+	assert.NotNil(t, standupConfig.IsValid(), "template doesn't parse")
+
+	standupConfig.ReportTemplate = "{{.Channel}}"
+	assert.NotNil(t, standupConfig.IsValid(), "unknown field")
+
+	standupConfig.ReportTemplate = strings.Repeat("a", reportTemplateMaxLength+1)
+	assert.NotNil(t, standupConfig.IsValid())
+}
+
+func TestRenderReport(t *testing.T) {
+	text, err := RenderReport(
+		"{{.Stats.SubmissionCount}}/{{.Stats.MemberCount}} submitted, {{.Stats.TaskCount}} tasks.{{range .Members}} {{.Username}}{{end}}. Missing: {{join .MembersNoStandup \", \"}}",
+		sampleReport,
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, "1/4 submitted, 3 tasks. john.doe. Missing: jane.doe", text)
+
+	_, err = RenderReport("{{if .MembersSkipped}}{{end}}", sampleReport)
+	assert.NotNil(t, err, "empty report")
+}
+
+func TestConfig_ReportDestinations(t *testing.T) {
+	standupConfig := scheduleTestConfig(t)
+
+	assert.Nil(t, standupConfig.AddReportDestinations([]string{"channel_2"}, []string{"user_id_2"}))
+	assert.Nil(t, standupConfig.AddReportDestinations([]string{"channel_2", "channel_3"}, nil))
+	assert.Equal(t, []string{"channel_2", "channel_3"}, standupConfig.ReportChannels)
+	assert.Equal(t, []string{"user_id_2"}, standupConfig.ReportUsers)
+	assert.Nil(t, standupConfig.IsValid())
+
+	assert.NotNil(t, standupConfig.AddReportDestinations([]string{standupConfig.ChannelID}, nil))
+
+	assert.False(t, standupConfig.RemoveReportDestinations([]string{"channel_3", "channel_4"}, nil))
+	assert.Equal(t, []string{"channel_2"}, standupConfig.ReportChannels)
+	assert.True(t, standupConfig.RemoveReportDestinations([]string{"channel_2"}, []string{"user_id_2"}))
+	assert.Empty(t, standupConfig.ReportChannels)
+	assert.Empty(t, standupConfig.ReportUsers)
+
+	standupConfig.ReportChannels = []string{"channel_2", "channel_2"}
+	assert.NotNil(t, standupConfig.IsValid(), "duplicate report channels")
+
+	standupConfig.ReportChannels = []string{standupConfig.ChannelID}
+	assert.NotNil(t, standupConfig.IsValid(), "standup channel as report channel")
+
+	standupConfig.ReportChannels = nil
+	for i := 0; i <= reportDestinationsMaxLength; i++ {
+		standupConfig.ReportUsers = append(standupConfig.ReportUsers, fmt.Sprintf("user_id_%d", i))
+	}
+	assert.NotNil(t, standupConfig.IsValid(), "too many report users")
+}
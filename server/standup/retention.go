@@ -0,0 +1,218 @@
+package standup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/logger"
+	"github.com/standup-raven/standup-raven/server/otime"
+)
+
+const (
+	// dataPurgeInitialLookbackDays is how far before the retention cutoff
+	// the first purge of a channel looks for data to delete at least.
+	// It looks further back if the standup is known to be older.
+	dataPurgeInitialLookbackDays = 365
+
+	// dataPurgeMaxDaysPerRun limits the days purged per channel in a single run
+	// so a large backlog is spread over multiple runs.
+	dataPurgeMaxDaysPerRun = 31
+)
+
+// PurgeSummary counts the entries removed by a data purge.
+type PurgeSummary struct {
+	Channels             int
+	UserStandups         int
+	NotificationStatuses int
+	ReminderPosts        int
+}
+
+// PurgeExpiredData deletes user standups and notification statuses older than
+// the configured retention period, along with leftover reminder posts of
+// channels with disabled standup. Nothing is deleted if retention period is not set.
+//
+// The last purged date of each channel standup is recorded so every day is only purged once.
+func PurgeExpiredData() (*PurgeSummary, error) {
+	summary := &PurgeSummary{}
+
+	retentionDays := config.GetConfig().DataRetentionDays
+	if retentionDays <= 0 {
+		return summary, nil
+	}
+
+	channels, err := GetStandupChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	purgeStatus, err := store.GetDataPurgeStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	for standupKey, channelID := range channels {
+		standupID := ParseStandupKey(standupKey, channelID)
+		lastPurgedDate, err := purgeChannelData(channelID, standupID, retentionDays, purgeStatus[standupKey], summary)
+		if err != nil {
+			// log and continue so one channel doesn't block purging others
+			logger.Error("Couldn't purge expired standup data of channel", err, map[string]interface{}{"channelID": channelID, "standupID": standupID})
+		}
+
+		if lastPurgedDate != "" {
+			purgeStatus[standupKey] = lastPurgedDate
+		}
+	}
+
+	if err := store.SetDataPurgeStatus(purgeStatus); err != nil {
+		return nil, err
+	}
+
+	logger.Info(fmt.Sprintf(
+		"Purged standup data older than %d days. Channels: %d, user standups: %d, notification statuses: %d, reminder post lists: %d",
+		retentionDays,
+		summary.Channels,
+		summary.UserStandups,
+		summary.NotificationStatuses,
+		summary.ReminderPosts,
+	), nil)
+
+	return summary, nil
+}
+
+// purgeChannelData purges expired data of the channel standup, starting the day after lastPurgedDate.
+// It returns the last date that was purged, which is lastPurgedDate itself if nothing was purged.
+func purgeChannelData(channelID, standupID string, retentionDays int, lastPurgedDate string, summary *PurgeSummary) (string, error) {
+	standupConfig, err := GetStandupConfig(channelID, standupID)
+	if err != nil {
+		return lastPurgedDate, err
+	}
+
+	if standupConfig == nil {
+		return lastPurgedDate, nil
+	}
+
+	// reminder posts are cleaned up when the standup report is sent,
+	// which never happens once the standup is disabled.
+	if !standupConfig.Enabled {
+		reminderPosts, err := store.GetReminderPosts(channelID, standupID)
+		if err != nil {
+			return lastPurgedDate, err
+		}
+
+		if len(reminderPosts) > 0 {
+			if err := store.DeleteReminderPosts(channelID, standupID); err != nil {
+				return lastPurgedDate, err
+			}
+			summary.ReminderPosts++
+		}
+	}
+
+	location, err := time.LoadLocation(standupConfig.Timezone)
+	if err != nil {
+		return lastPurgedDate, err
+	}
+
+	now := otime.Now(standupConfig.Timezone)
+	cutoff := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location).AddDate(0, 0, -retentionDays)
+
+	history, err := store.GetStandupConfigHistory(channelID, standupID)
+	if err != nil {
+		return lastPurgedDate, err
+	}
+
+	start := getInitialPurgeDate(standupConfig, history, cutoff)
+	if lastPurgedDate != "" {
+		lastPurged, err := otime.ParseDate(lastPurgedDate, standupConfig.Timezone)
+		if err != nil {
+			return lastPurgedDate, err
+		}
+		start = lastPurged.AddDate(0, 0, 1)
+	}
+
+	if start.After(cutoff) {
+		return lastPurgedDate, nil
+	}
+
+	members := getAllStandupMembers(standupConfig, history)
+
+	summary.Channels++
+
+	for date, days := start, 0; !date.After(cutoff) && days < dataPurgeMaxDaysPerRun; date, days = date.AddDate(0, 0, 1), days+1 {
+		dateString := otime.OTime{Time: date}.GetDateString()
+
+		for _, userID := range members {
+			deleted, err := store.DeleteUserStandup(userID, channelID, standupID, dateString)
+			if err != nil {
+				return lastPurgedDate, err
+			}
+
+			if deleted {
+				summary.UserStandups++
+			}
+		}
+
+		deleted, err := store.DeleteNotificationStatus(channelID, standupID, dateString)
+		if err != nil {
+			return lastPurgedDate, err
+		}
+
+		if deleted {
+			summary.NotificationStatuses++
+		}
+
+		lastPurgedDate = date.Format(otime.LayoutISODate)
+	}
+
+	return lastPurgedDate, nil
+}
+
+// getInitialPurgeDate returns the date the first purge of a channel standup starts from.
+// That's the earliest of the standup's schedule start date, its oldest recorded config
+// version and dataPurgeInitialLookbackDays before the cutoff, so data from before
+// the retention setting was introduced is purged too.
+func getInitialPurgeDate(standupConfig *Config, history []*ConfigVersion, cutoff time.Time) time.Time {
+	start := cutoff.AddDate(0, 0, -dataPurgeInitialLookbackDays)
+	if !standupConfig.StartDate.IsZero() && standupConfig.StartDate.Before(start) {
+		start = standupConfig.StartDate
+	}
+
+	for _, version := range history {
+		if !version.CreatedAt.IsZero() && version.CreatedAt.Before(start) {
+			start = version.CreatedAt
+		}
+	}
+
+	return time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, cutoff.Location())
+}
+
+// getAllStandupMembers returns current standup members along with
+// previous members recorded in channel's config history.
+//
+// Config history is only recorded since it was introduced and only its most recent
+// versions are kept, so members removed before that aren't known. As KV store keys
+// are hashed they can't be listed to find such members either, so their standups
+// are never purged.
+func getAllStandupMembers(standupConfig *Config, history []*ConfigVersion) []string {
+	members := map[string]bool{}
+	for _, userID := range standupConfig.Members {
+		members[userID] = true
+	}
+
+	for _, version := range history {
+		if version.Config == nil {
+			continue
+		}
+
+		for _, userID := range version.Config.Members {
+			members[userID] = true
+		}
+	}
+
+	userIDs := make([]string, 0, len(members))
+	for userID := range members {
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs
+}
@@ -0,0 +1,119 @@
+package standup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/otime"
+)
+
+func TestPurgeExpiredData(t *testing.T) {
+	defer TearDown()
+	baseMock()
+
+	SetStore(NewMemoryStore())
+	defer SetStore(&KVStore{})
+
+	standupConfig := configHistoryTestConfig("section_1")
+	standupConfig.Members = []string{"user_id_1", "user_id_2"}
+	assert.Nil(t, GetStore().SaveStandupConfig(standupConfig))
+	assert.Nil(t, AddStandupChannel("channel_id", ""))
+
+	// removed member whose standups should be purged too
+	formerConfig := configHistoryTestConfig("section_1")
+	formerConfig.Members = []string{"user_id_3"}
+	now := otime.Now(standupConfig.Timezone)
+	assert.Nil(t, GetStore().SetStandupConfigHistory("channel_id", "", []*ConfigVersion{
+		{Version: 1, Config: formerConfig, CreatedAt: now.AddDate(0, 0, -420)},
+	}))
+
+	dates := map[int]string{}
+	for _, daysAgo := range []int{0, 9, 10, 11, 40, 400} {
+		date := otime.OTime{Time: now.AddDate(0, 0, -daysAgo)}.GetDateString()
+		dates[daysAgo] = date
+
+		for _, userID := range []string{"user_id_1", "user_id_2", "user_id_3"} {
+			assert.Nil(t, GetStore().SaveUserStandup(date, &UserStandup{UserID: userID, ChannelID: "channel_id"}))
+		}
+		assert.Nil(t, GetStore().SetNotificationStatus("channel_id", "", date, &ChannelNotificationStatus{StandupReportSent: true}))
+	}
+
+	// retention not configured
+	summary, err := PurgeExpiredData()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, summary.UserStandups)
+
+	config.GetConfig().DataRetentionDays = 10
+
+	// initial backlog is purged over multiple runs
+	total := &PurgeSummary{}
+	for runs := 0; ; runs++ {
+		if runs == 30 {
+			t.Fatal("purge should be complete in a limited number of runs")
+		}
+
+		summary, err = PurgeExpiredData()
+		assert.Nil(t, err)
+		if summary.Channels == 0 {
+			break
+		}
+
+		total.UserStandups += summary.UserStandups
+		total.NotificationStatuses += summary.NotificationStatuses
+	}
+
+	assert.Equal(t, 12, total.UserStandups)
+	assert.Equal(t, 4, total.NotificationStatuses)
+
+	for daysAgo, date := range dates {
+		userStandup, err := GetStore().GetUserStandup("user_id_3", "channel_id", "", date)
+		assert.Nil(t, err)
+		status, err := GetStore().GetNotificationStatus("channel_id", "", date)
+		assert.Nil(t, err)
+
+		if daysAgo >= 10 {
+			assert.Nil(t, userStandup, "data from %d days ago should be purged", daysAgo)
+			assert.Nil(t, status, "data from %d days ago should be purged", daysAgo)
+		} else {
+			assert.NotNil(t, userStandup, "data from %d days ago should be retained", daysAgo)
+			assert.NotNil(t, status, "data from %d days ago should be retained", daysAgo)
+		}
+	}
+
+	// leftover reminder posts of disabled standup
+	standupConfig.Enabled = false
+	assert.Nil(t, GetStore().SaveStandupConfig(standupConfig))
+	assert.Nil(t, GetStore().SetReminderPosts("channel_id", "", []string{"post_id_1"}))
+
+	summary, err = PurgeExpiredData()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, summary.ReminderPosts)
+
+	reminderPosts, err := GetStore().GetReminderPosts("channel_id", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(reminderPosts))
+}
+
+func TestGetInitialPurgeDate(t *testing.T) {
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	cutoff := time.Date(2021, 1, 10, 0, 0, 0, 0, location)
+	lookbackStart := cutoff.AddDate(0, 0, -dataPurgeInitialLookbackDays)
+
+	standupConfig := configHistoryTestConfig("section_1")
+	assert.Equal(t, lookbackStart, getInitialPurgeDate(standupConfig, nil, cutoff))
+
+	// standup started before the lookback
+	standupConfig.StartDate = time.Date(2019, 6, 1, 0, 0, 0, 0, location)
+	assert.Equal(t, standupConfig.StartDate, getInitialPurgeDate(standupConfig, nil, cutoff))
+
+	// config recorded before the standup's current start date
+	history := []*ConfigVersion{{Version: 1, CreatedAt: time.Date(2018, 3, 4, 15, 30, 0, 0, location)}}
+	assert.Equal(t, time.Date(2018, 3, 4, 0, 0, 0, 0, location), getInitialPurgeDate(standupConfig, history, cutoff))
+
+	// recent start dates don't shorten the lookback
+	standupConfig.StartDate = time.Date(2020, 12, 1, 0, 0, 0, 0, location)
+	assert.Equal(t, lookbackStart, getInitialPurgeDate(standupConfig, nil, cutoff))
+}
@@ -0,0 +1,223 @@
+package standup
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/teambition/rrule-go"
+	"github.com/thoas/go-funk"
+
+	"github.com/standup-raven/standup-raven/server/otime"
+	"github.com/standup-raven/standup-raven/server/util"
+)
+
+// ScheduledStandup is an occurrence of standup in its schedule.
+type ScheduledStandup struct {
+	// date in YYYY-MM-DD format
+	Date string `json:"date"`
+	// window times in standup timezone
+	WindowOpen  time.Time `json:"windowOpen"`
+	WindowClose time.Time `json:"windowClose"`
+	// skipped dates are occurrences of standup's recurrence rule
+	// on which no standup is held
+	Skipped bool `json:"skipped"`
+}
+
+// ScheduleSet returns the standup schedule as an RRULE set. The set combines
+// standup's recurrence rule with dates skipped from it (EXDATE) and
+// additional dates standup is held on (RDATE).
+func (sc *Config) ScheduleSet() *rrule.Set {
+	set := &rrule.Set{}
+	set.RRule(sc.RRule)
+
+	location := sc.location()
+
+	for _, date := range sc.ExDates {
+		day, err := time.ParseInLocation(otime.LayoutISODate, date, location)
+		if err != nil {
+			continue
+		}
+
+		// EXDATE only excludes occurrences matching it exactly,
+		// so the rule's own occurrences on the date are used.
+		for _, occurrence := range sc.ruleOccurrences(day) {
+			set.ExDate(occurrence)
+		}
+	}
+
+	for _, date := range sc.RDates {
+		day, err := time.ParseInLocation(otime.LayoutISODate, date, location)
+		if err != nil {
+			continue
+		}
+
+		set.RDate(day)
+	}
+
+	return set
+}
+
+// SkipDate skips standup on the calendar date of the specified time.
+// Additional dates are removed and dates in standup's recurrence rule are added as exceptions.
+func (sc *Config) SkipDate(date time.Time) error {
+	day := sc.startOfDay(date)
+	dateString := day.Format(otime.LayoutISODate)
+
+	if funk.ContainsString(sc.RDates, dateString) {
+		sc.RDates = util.Difference(sc.RDates, []string{dateString})
+		return nil
+	}
+
+	if funk.ContainsString(sc.ExDates, dateString) {
+		return fmt.Errorf("standup is already skipped on %s", dateString)
+	}
+
+	if len(sc.ruleOccurrences(day)) == 0 {
+		return fmt.Errorf("no standup is scheduled on %s", dateString)
+	}
+
+	sc.ExDates = append(sc.ExDates, dateString)
+	return nil
+}
+
+// UnskipDate schedules standup on the calendar date of the specified time.
+// Skipped dates are restored and other dates are added as additional standup dates.
+func (sc *Config) UnskipDate(date time.Time) error {
+	day := sc.startOfDay(date)
+	dateString := day.Format(otime.LayoutISODate)
+
+	if funk.ContainsString(sc.ExDates, dateString) {
+		sc.ExDates = util.Difference(sc.ExDates, []string{dateString})
+		return nil
+	}
+
+	if funk.ContainsString(sc.RDates, dateString) || len(sc.ruleOccurrences(day)) > 0 {
+		return fmt.Errorf("standup is already scheduled on %s", dateString)
+	}
+
+	sc.RDates = append(sc.RDates, dateString)
+	return nil
+}
+
+// StandupDatesBetween returns dates standup is held on after the calendar date of after
+// and before the calendar date of before, as midnight in standup timezone.
+func (sc *Config) StandupDatesBetween(after, before time.Time) []time.Time {
+	from := sc.startOfDay(after).AddDate(0, 0, 1)
+	to := sc.startOfDay(before)
+
+	dates := []time.Time{}
+	for _, occurrence := range sc.ScheduleSet().Between(from.Add(-1*time.Second), to, false) {
+		date := sc.startOfDay(occurrence.In(sc.location()))
+		if len(dates) == 0 || !dates[len(dates)-1].Equal(date) {
+			dates = append(dates, date)
+		}
+	}
+
+	return dates
+}
+
+// PreviousStandupDate returns the last date standup is held on before the calendar date
+// of the specified time, as midnight in standup timezone. Returns zero time if there is none.
+func (sc *Config) PreviousStandupDate(before time.Time) time.Time {
+	occurrence := sc.ScheduleSet().Before(sc.startOfDay(before), false)
+	if occurrence.IsZero() {
+		return occurrence
+	}
+
+	return sc.startOfDay(occurrence.In(sc.location()))
+}
+
+// UpcomingStandups returns the next count standup dates whose window hasn't closed
+// at the specified time. Skipped dates falling between them are included as well,
+// marked as skipped, and don't count towards count.
+func (sc *Config) UpcomingStandups(from time.Time, count int) []*ScheduledStandup {
+	standups := []*ScheduledStandup{}
+	if sc.RRule == nil {
+		return standups
+	}
+
+	location := sc.location()
+	start := sc.StandupDate(from.In(location))
+	if _, windowClose := sc.Window(start); !from.Before(windowClose) {
+		start = start.AddDate(0, 0, 1)
+	}
+
+	// skipped dates are listed too, so they aren't excluded from the set
+	set := sc.ScheduleSet()
+	set.SetExDates(nil)
+
+	held := 0
+	next := set.Iterator()
+	for occurrence, ok := next(); ok && held < count; occurrence, ok = next() {
+		date := sc.startOfDay(occurrence.In(location))
+		if date.Before(start) {
+			continue
+		}
+
+		dateString := date.Format(otime.LayoutISODate)
+		if len(standups) > 0 && standups[len(standups)-1].Date == dateString {
+			continue
+		}
+
+		windowOpen, windowClose := sc.Window(date)
+		scheduledStandup := &ScheduledStandup{
+			Date:        dateString,
+			WindowOpen:  windowOpen,
+			WindowClose: windowClose,
+			Skipped:     funk.ContainsString(sc.ExDates, dateString),
+		}
+
+		standups = append(standups, scheduledStandup)
+		if !scheduledStandup.Skipped {
+			held++
+		}
+	}
+
+	return standups
+}
+
+// validateScheduleExceptions checks that skipped and additional dates
+// are valid dates without duplicates or conflicts.
+func (sc *Config) validateScheduleExceptions() error {
+	for _, date := range append(append([]string{}, sc.ExDates...), sc.RDates...) {
+		if _, err := time.Parse(otime.LayoutISODate, date); err != nil {
+			return fmt.Errorf("invalid schedule exception date \"%s\". Dates must be in YYYY-MM-DD format", date)
+		}
+	}
+
+	if duplicateDate, hasDuplicate := util.ContainsDuplicates(&sc.ExDates); hasDuplicate {
+		return errors.New("Duplicate skipped dates are not allowed. Contains duplicate date '" + duplicateDate + "'")
+	}
+
+	if duplicateDate, hasDuplicate := util.ContainsDuplicates(&sc.RDates); hasDuplicate {
+		return errors.New("Duplicate additional dates are not allowed. Contains duplicate date '" + duplicateDate + "'")
+	}
+
+	for _, date := range sc.ExDates {
+		if funk.ContainsString(sc.RDates, date) {
+			return errors.New("date '" + date + "' cannot be both skipped and an additional standup date")
+		}
+	}
+
+	return nil
+}
+
+// ruleOccurrences returns occurrences of standup's recurrence rule on the specified day.
+func (sc *Config) ruleOccurrences(day time.Time) []time.Time {
+	return sc.RRule.Between(day.Add(-1*time.Minute), day.AddDate(0, 0, 1), false)
+}
+
+// startOfDay returns the start of calendar date of the specified time in standup timezone.
+func (sc *Config) startOfDay(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, sc.location())
+}
+
+func (sc *Config) location() *time.Location {
+	location, err := time.LoadLocation(sc.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
@@ -0,0 +1,165 @@
+package standup
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/standup-raven/standup-raven/server/otime"
+)
+
+func scheduleTestConfig(t *testing.T) *Config {
+	standupConfig := configHistoryTestConfig("section_1")
+	// a Wednesday
+	standupConfig.StartDate = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Nil(t, standupConfig.PreSave())
+	return standupConfig
+}
+
+func scheduleDates(standupConfig *Config, from, to time.Time) []string {
+	dates := []string{}
+	for _, date := range standupConfig.ScheduleSet().Between(from, to, true) {
+		dates = append(dates, date.Format("2006-01-02"))
+	}
+
+	return dates
+}
+
+func TestConfig_SkipDate(t *testing.T) {
+	standupConfig := scheduleTestConfig(t)
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	from := time.Date(2020, 1, 3, 0, 0, 0, 0, location)
+	to := time.Date(2020, 1, 8, 0, 0, 0, 0, location)
+
+	assert.Equal(t, []string{"2020-01-03", "2020-01-06", "2020-01-07", "2020-01-08"}, scheduleDates(standupConfig, from, to))
+
+	assert.Nil(t, standupConfig.SkipDate(time.Date(2020, 1, 6, 0, 0, 0, 0, location)))
+	assert.Equal(t, []string{"2020-01-06"}, standupConfig.ExDates)
+	assert.Equal(t, []string{"2020-01-03", "2020-01-07", "2020-01-08"}, scheduleDates(standupConfig, from, to))
+	assert.Nil(t, standupConfig.IsValid())
+
+	assert.NotNil(t, standupConfig.SkipDate(time.Date(2020, 1, 6, 0, 0, 0, 0, location)), "date is already skipped")
+	assert.NotNil(t, standupConfig.SkipDate(time.Date(2020, 1, 4, 0, 0, 0, 0, location)), "no standup on Saturday")
+
+	// exceptions should survive the config being stored
+	data, err := json.Marshal(standupConfig)
+	assert.Nil(t, err)
+	storedConfig := &Config{}
+	assert.Nil(t, json.Unmarshal(data, storedConfig))
+	assert.Equal(t, []string{"2020-01-03", "2020-01-07", "2020-01-08"}, scheduleDates(storedConfig, from, to))
+}
+
+func TestConfig_UnskipDate(t *testing.T) {
+	standupConfig := scheduleTestConfig(t)
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	from := time.Date(2020, 1, 3, 0, 0, 0, 0, location)
+	to := time.Date(2020, 1, 6, 0, 0, 0, 0, location)
+
+	assert.Nil(t, standupConfig.SkipDate(time.Date(2020, 1, 3, 0, 0, 0, 0, location)))
+	assert.Nil(t, standupConfig.UnskipDate(time.Date(2020, 1, 3, 0, 0, 0, 0, location)))
+	assert.Empty(t, standupConfig.ExDates)
+	assert.Empty(t, standupConfig.RDates)
+
+	assert.NotNil(t, standupConfig.UnskipDate(time.Date(2020, 1, 3, 0, 0, 0, 0, location)), "standup is already scheduled")
+
+	assert.Nil(t, standupConfig.UnskipDate(time.Date(2020, 1, 4, 0, 0, 0, 0, location)))
+	assert.Equal(t, []string{"2020-01-04"}, standupConfig.RDates)
+	assert.Equal(t, []string{"2020-01-03", "2020-01-04", "2020-01-06"}, scheduleDates(standupConfig, from, to))
+	assert.Nil(t, standupConfig.IsValid())
+
+	assert.Nil(t, standupConfig.SkipDate(time.Date(2020, 1, 4, 0, 0, 0, 0, location)))
+	assert.Empty(t, standupConfig.RDates)
+	assert.Empty(t, standupConfig.ExDates)
+	assert.Equal(t, []string{"2020-01-03", "2020-01-06"}, scheduleDates(standupConfig, from, to))
+}
+
+func TestConfig_IsValid_ScheduleExceptions(t *testing.T) {
+	standupConfig := scheduleTestConfig(t)
+	standupConfig.ExDates = []string{"06-01-2020"}
+	assert.NotNil(t, standupConfig.IsValid())
+
+	standupConfig.ExDates = []string{"2020-01-06", "2020-01-06"}
+	assert.NotNil(t, standupConfig.IsValid())
+
+	standupConfig.ExDates = []string{"2020-01-06"}
+	standupConfig.RDates = []string{"2020-01-04", "2020-01-04"}
+	assert.NotNil(t, standupConfig.IsValid())
+
+	standupConfig.RDates = []string{"2020-01-06"}
+	assert.NotNil(t, standupConfig.IsValid())
+
+	standupConfig.RDates = []string{"2020-01-04"}
+	assert.Nil(t, standupConfig.IsValid())
+}
+
+func TestConfig_StandupDatesBetween(t *testing.T) {
+	standupConfig := scheduleTestConfig(t)
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	assert.Nil(t, standupConfig.SkipDate(time.Date(2020, 1, 7, 0, 0, 0, 0, location)))
+	assert.Nil(t, standupConfig.UnskipDate(time.Date(2020, 1, 4, 0, 0, 0, 0, location)))
+
+	format := func(dates []time.Time) []string {
+		formatted := []string{}
+		for _, date := range dates {
+			formatted = append(formatted, date.Format("2006-01-02 15:04 MST"))
+		}
+
+		return formatted
+	}
+
+	// both dates are excluded
+	assert.Equal(
+		t,
+		[]string{"2020-01-03 00:00 IST", "2020-01-04 00:00 IST", "2020-01-06 00:00 IST"},
+		format(standupConfig.StandupDatesBetween(time.Date(2020, 1, 2, 15, 0, 0, 0, location), time.Date(2020, 1, 8, 0, 0, 0, 0, location))),
+	)
+	assert.Empty(t, standupConfig.StandupDatesBetween(time.Date(2020, 1, 6, 0, 0, 0, 0, location), time.Date(2020, 1, 8, 0, 0, 0, 0, location)))
+
+	assert.Equal(t, time.Date(2020, 1, 6, 0, 0, 0, 0, location), standupConfig.PreviousStandupDate(time.Date(2020, 1, 8, 10, 0, 0, 0, location)))
+	assert.Equal(t, time.Date(2020, 1, 4, 0, 0, 0, 0, location), standupConfig.PreviousStandupDate(time.Date(2020, 1, 6, 0, 0, 0, 0, location)))
+	assert.True(t, standupConfig.PreviousStandupDate(time.Date(2020, 1, 1, 10, 0, 0, 0, location)).IsZero())
+}
+
+func TestConfig_UpcomingStandups(t *testing.T) {
+	standupConfig := scheduleTestConfig(t)
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	assert.Nil(t, standupConfig.SkipDate(time.Date(2020, 1, 6, 0, 0, 0, 0, location)))
+	assert.Nil(t, standupConfig.UnskipDate(time.Date(2020, 1, 4, 0, 0, 0, 0, location)))
+
+	dates := func(standups []*ScheduledStandup) []string {
+		dates := []string{}
+		for _, scheduledStandup := range standups {
+			date := scheduledStandup.Date
+			if scheduledStandup.Skipped {
+				date += " skipped"
+			}
+			dates = append(dates, date)
+		}
+		return dates
+	}
+
+	// Friday, during standup window
+	upcomingStandups := standupConfig.UpcomingStandups(time.Date(2020, 1, 3, 10, 30, 0, 0, location), 3)
+	assert.Equal(t, []string{"2020-01-03", "2020-01-04", "2020-01-06 skipped", "2020-01-07"}, dates(upcomingStandups))
+	assert.Equal(t, time.Date(2020, 1, 3, 10, 0, 0, 0, location), upcomingStandups[0].WindowOpen)
+	assert.Equal(t, time.Date(2020, 1, 3, 11, 0, 0, 0, location), upcomingStandups[0].WindowClose)
+	assert.Equal(t, time.Date(2020, 1, 6, 10, 0, 0, 0, location), upcomingStandups[2].WindowOpen)
+
+	// after window closed today
+	upcomingStandups = standupConfig.UpcomingStandups(time.Date(2020, 1, 3, 11, 0, 0, 0, location), 2)
+	assert.Equal(t, []string{"2020-01-04", "2020-01-06 skipped", "2020-01-07"}, dates(upcomingStandups))
+
+	// window crossing midnight, after midnight the window is still open
+	standupConfig.WindowOpenTime, _ = otime.Parse("22:00")
+	standupConfig.WindowCloseTime, _ = otime.Parse("02:00")
+	upcomingStandups = standupConfig.UpcomingStandups(time.Date(2020, 1, 8, 1, 0, 0, 0, location), 1)
+	assert.Equal(t, []string{"2020-01-07"}, dates(upcomingStandups))
+	assert.Equal(t, time.Date(2020, 1, 8, 2, 0, 0, 0, location), upcomingStandups[0].WindowClose)
+
+	// recurrence rule ending
+	standupConfig.RRule.Until(time.Date(2020, 1, 8, 12, 0, 0, 0, location))
+	upcomingStandups = standupConfig.UpcomingStandups(time.Date(2020, 1, 8, 12, 0, 0, 0, location), 5)
+	assert.Equal(t, []string{"2020-01-08"}, dates(upcomingStandups))
+}
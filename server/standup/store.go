@@ -0,0 +1,135 @@
+package standup
+
+import (
+	"fmt"
+
+	"github.com/standup-raven/standup-raven/server/config"
+)
+
+// ChannelNotificationStatus tracks which standup notifications
+// and reports have been sent in a channel on a given day.
+type ChannelNotificationStatus struct {
+	// indexes of reminders sent, in standup's reminder schedule
+	RemindersSent     []int `json:"remindersSent,omitempty"`
+	StandupReportSent bool  `json:"standupReportSent"`
+
+	// ID of the standup report post, once the report is posted in the channel
+	ReportPostID string `json:"reportPostId,omitempty"`
+
+	// indexes of reminders sent to each member individually,
+	// when standup member timezones are enabled
+	MemberRemindersSent map[string][]int `json:"memberRemindersSent,omitempty"`
+
+	// IDs of members who chose to skip their standup on the day
+	MembersSkipped []string `json:"membersSkipped,omitempty"`
+}
+
+// Store persists all standup data.
+// Dates are always specified in the "20060102" format.
+// Getters return nil values without any error if the requested item doesn't exist.
+// Standup data of DefaultStandupID is stored under the same keys as
+// before channels could have multiple standups.
+type Store interface {
+	GetSchemaVersion() (string, error)
+	SetSchemaVersion(version string) error
+
+	GetStandupChannels() (map[string]string, error)
+	SetStandupChannels(channels map[string]string) error
+
+	GetStandupConfig(channelID, standupID string) (*Config, error)
+	SaveStandupConfig(standupConfig *Config) error
+	ArchiveStandupConfig(channelID string) error
+	GetArchivedStandupConfig(channelID string) (*Config, error)
+	GetArchivedStandupConfigs() ([]*Config, error)
+	DeleteArchivedStandupConfig(channelID string) error
+
+	GetStandupConfigHistory(channelID, standupID string) ([]*ConfigVersion, error)
+	SetStandupConfigHistory(channelID, standupID string, history []*ConfigVersion) error
+
+	GetUserStandup(userID, channelID, standupID, date string) (*UserStandup, error)
+	SaveUserStandup(date string, userStandup *UserStandup) error
+	// DeleteUserStandup reports whether the user standup existed.
+	DeleteUserStandup(userID, channelID, standupID, date string) (bool, error)
+
+	GetNotificationStatus(channelID, standupID, date string) (*ChannelNotificationStatus, error)
+	SetNotificationStatus(channelID, standupID, date string, status *ChannelNotificationStatus) error
+	// DeleteNotificationStatus reports whether the notification status existed.
+	DeleteNotificationStatus(channelID, standupID, date string) (bool, error)
+
+	// GetLastProcessedOccurrence returns the date of the latest standup occurrence
+	// processed by the scheduler, or an empty string if none has been processed yet.
+	GetLastProcessedOccurrence(channelID, standupID string) (string, error)
+	SetLastProcessedOccurrence(channelID, standupID, date string) error
+
+	GetReminderPosts(channelID, standupID string) ([]string, error)
+	SetReminderPosts(channelID, standupID string, postIDs []string) error
+	DeleteReminderPosts(channelID, standupID string) error
+
+	GetDataPurgeStatus() (map[string]string, error)
+	SetDataPurgeStatus(status map[string]string) error
+
+	// GetUserOutOfOffice may return a period which has already ended.
+	GetUserOutOfOffice(userID string) (*OutOfOffice, error)
+	// SetUserOutOfOffice may discard the period once it has ended.
+	SetUserOutOfOffice(userID string, outOfOffice *OutOfOffice) error
+	// DeleteUserOutOfOffice reports whether the user had an out of office period.
+	DeleteUserOutOfOffice(userID string) (bool, error)
+}
+
+// archivedKeySuffix is appended to the key of an archived
+// standup config. For KVStore it's appended after hashing.
+const archivedKeySuffix = "_DEL"
+
+// store is only replaced during plugin activation and in tests,
+// so it doesn't need any synchronization.
+var store Store = &KVStore{}
+
+// GetStore returns the store used for all standup data.
+func GetStore() Store {
+	return store
+}
+
+// SetStore replaces the store used for all standup data.
+func SetStore(s Store) {
+	store = s
+}
+
+// withStandupID suffixes the key with standup ID, leaving keys
+// of the default standup same as they were before multiple standups.
+func withStandupID(key, standupID string) string {
+	if standupID == DefaultStandupID {
+		return key
+	}
+
+	return key + "_" + standupID
+}
+
+// standupConfigKey is used for the archived config as well,
+// which exists only for the default standup.
+func standupConfigKey(channelID, standupID string) string {
+	return withStandupID(config.CacheKeyPrefixTeamStandupConfig+channelID, standupID)
+}
+
+func standupConfigHistoryKey(channelID, standupID string) string {
+	return withStandupID(config.CacheKeyPrefixConfigHistory+channelID, standupID)
+}
+
+func userStandupKey(userID, channelID, standupID, date string) string {
+	return withStandupID(date+"_"+channelID+userID, standupID)
+}
+
+func notificationStatusKey(channelID, standupID, date string) string {
+	return withStandupID(fmt.Sprintf("%s_%s_%s", config.CacheKeyPrefixNotificationStatus, channelID, date), standupID)
+}
+
+func lastProcessedOccurrenceKey(channelID, standupID string) string {
+	return withStandupID(config.CacheKeyPrefixLastProcessed+channelID, standupID)
+}
+
+func userOutOfOfficeKey(userID string) string {
+	return config.CacheKeyOutOfOffice + "_" + userID
+}
+
+func reminderPostsKey(channelID, standupID string) string {
+	return withStandupID(fmt.Sprintf("%s_%s", config.CacheKeyPrefixReminderPosts, channelID), standupID)
+}
@@ -0,0 +1,501 @@
+package standup
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/standup-raven/standup-raven/server/config"
+	"github.com/standup-raven/standup-raven/server/logger"
+	"github.com/standup-raven/standup-raven/server/otime"
+	"github.com/standup-raven/standup-raven/server/util"
+)
+
+const (
+	// kvListPageSize is the number of keys fetched per page when scanning the KV store.
+	kvListPageSize = 100
+
+	// outOfOfficeExpiryDays is the number of days after its last date
+	// an out of office period is removed from the KV store.
+	outOfOfficeExpiryDays = 2
+)
+
+// KVStore is a Store backed by the Mattermost plugin KV store.
+// All keys are hashed before being stored.
+type KVStore struct{}
+
+func (s *KVStore) GetSchemaVersion() (string, error) {
+	data, appErr := config.Mattermost.KVGet(util.GetKeyHash(config.CacheKeyDatabaseSchemaVersion))
+	if appErr != nil {
+		logger.Error("Couldn't fetch database schema version from KV store", appErr, nil)
+		return "", errors.New(appErr.Error())
+	}
+
+	if data == nil {
+		return "", nil
+	}
+
+	var version string
+	if err := json.Unmarshal(data, &version); err != nil {
+		logger.Error("Couldn't unmarshal database schema version", err, nil)
+		return "", err
+	}
+
+	return version, nil
+}
+
+func (s *KVStore) SetSchemaVersion(version string) error {
+	data, err := json.Marshal(version)
+	if err != nil {
+		logger.Error("Couldn't marshal database schema version", err, nil)
+		return err
+	}
+
+	if appErr := config.Mattermost.KVSet(util.GetKeyHash(config.CacheKeyDatabaseSchemaVersion), data); appErr != nil {
+		logger.Error("Couldn't update database version into KV store", appErr, nil)
+		return errors.New(appErr.Error())
+	}
+
+	return nil
+}
+
+func (s *KVStore) GetStandupChannels() (map[string]string, error) {
+	data, appErr := config.Mattermost.KVGet(util.GetKeyHash(config.CacheKeyAllStandupChannels))
+	if appErr != nil {
+		logger.Error("Couldn't fetch standup channel list from KV store", appErr, nil)
+		return nil, errors.New(appErr.Error())
+	}
+
+	channels := map[string]string{}
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &channels); err != nil {
+			logger.Error("Couldn't unmarshal standup channel list into map", err, map[string]interface{}{"data": string(data)})
+			return nil, err
+		}
+	}
+
+	return channels, nil
+}
+
+func (s *KVStore) SetStandupChannels(channels map[string]string) error {
+	data, err := json.Marshal(channels)
+	if err != nil {
+		return err
+	}
+
+	if appErr := config.Mattermost.KVSet(util.GetKeyHash(config.CacheKeyAllStandupChannels), data); appErr != nil {
+		return errors.New(appErr.Error())
+	}
+
+	return nil
+}
+
+func (s *KVStore) GetStandupConfig(channelID, standupID string) (*Config, error) {
+	data, appErr := config.Mattermost.KVGet(util.GetKeyHash(standupConfigKey(channelID, standupID)))
+	if appErr != nil {
+		logger.Error("Couldn't fetch standup config for channel from KV store", appErr, map[string]interface{}{"channelID": channelID, "standupID": standupID})
+		return nil, errors.New(appErr.Error())
+	}
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	standupConfig := &Config{}
+	if err := json.Unmarshal(data, standupConfig); err != nil {
+		logger.Error("Couldn't unmarshal data into standup config", err, nil)
+		return nil, err
+	}
+
+	return standupConfig, nil
+}
+
+func (s *KVStore) SaveStandupConfig(standupConfig *Config) error {
+	data, err := json.Marshal(standupConfig)
+	if err != nil {
+		logger.Error("Couldn't marshal standup config", err, nil)
+		return err
+	}
+
+	if appErr := config.Mattermost.KVSet(util.GetKeyHash(standupConfigKey(standupConfig.ChannelID, standupConfig.StandupID)), data); appErr != nil {
+		logger.Error("Couldn't save channel standup config in KV store", appErr, map[string]interface{}{"channelID": standupConfig.ChannelID, "standupID": standupConfig.StandupID})
+		return errors.New(appErr.Error())
+	}
+
+	return nil
+}
+
+// ArchiveStandupConfig moves the channel standup config to
+// an archive key, suffixed with "_DEL", and deletes the original.
+func (s *KVStore) ArchiveStandupConfig(channelID string) error {
+	key := util.GetKeyHash(standupConfigKey(channelID, DefaultStandupID))
+	data, appErr := config.Mattermost.KVGet(key)
+	if appErr != nil {
+		logger.Error("Couldn't fetch standup config for channel from KV store", appErr, map[string]interface{}{"channelID": channelID})
+		return errors.New(appErr.Error())
+	}
+
+	if appErr := config.Mattermost.KVSet(key+archivedKeySuffix, data); appErr != nil {
+		logger.Error("Failed to save archived copy of channel configuration.", appErr, map[string]interface{}{"channel_id": channelID})
+		return errors.New(appErr.Error())
+	}
+
+	if appErr := config.Mattermost.KVDelete(key); appErr != nil {
+		logger.Error("Failed to delete standup config after saving archived copy.", appErr, map[string]interface{}{"channel_id": channelID})
+		return errors.New(appErr.Error())
+	}
+
+	return nil
+}
+
+func (s *KVStore) GetArchivedStandupConfig(channelID string) (*Config, error) {
+	return s.getArchivedStandupConfig(util.GetKeyHash(standupConfigKey(channelID, DefaultStandupID)) + archivedKeySuffix)
+}
+
+// GetArchivedStandupConfigs scans all plugin keys for archived standup configs.
+func (s *KVStore) GetArchivedStandupConfigs() ([]*Config, error) {
+	archivedConfigs := []*Config{}
+
+	for page := 0; ; page++ {
+		keys, appErr := config.Mattermost.KVList(page, kvListPageSize)
+		if appErr != nil {
+			logger.Error("Couldn't list keys from KV store", appErr, map[string]interface{}{"page": page})
+			return nil, errors.New(appErr.Error())
+		}
+
+		for _, key := range keys {
+			if !strings.HasSuffix(key, archivedKeySuffix) {
+				continue
+			}
+
+			archivedConfig, err := s.getArchivedStandupConfig(key)
+			if err != nil {
+				return nil, err
+			}
+
+			if archivedConfig != nil {
+				archivedConfigs = append(archivedConfigs, archivedConfig)
+			}
+		}
+
+		if len(keys) < kvListPageSize {
+			break
+		}
+	}
+
+	return archivedConfigs, nil
+}
+
+func (s *KVStore) getArchivedStandupConfig(key string) (*Config, error) {
+	data, appErr := config.Mattermost.KVGet(key)
+	if appErr != nil {
+		logger.Error("Couldn't fetch archived standup config from KV store", appErr, map[string]interface{}{"key": key})
+		return nil, errors.New(appErr.Error())
+	}
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	archivedConfig := &Config{}
+	if err := json.Unmarshal(data, archivedConfig); err != nil {
+		logger.Error("Couldn't unmarshal data into archived standup config", err, map[string]interface{}{"key": key})
+		return nil, err
+	}
+
+	return archivedConfig, nil
+}
+
+func (s *KVStore) DeleteArchivedStandupConfig(channelID string) error {
+	if appErr := config.Mattermost.KVDelete(util.GetKeyHash(standupConfigKey(channelID, DefaultStandupID)) + archivedKeySuffix); appErr != nil {
+		logger.Error("Couldn't delete archived standup config from KV store", appErr, map[string]interface{}{"channelID": channelID})
+		return errors.New(appErr.Error())
+	}
+
+	return nil
+}
+
+func (s *KVStore) GetStandupConfigHistory(channelID, standupID string) ([]*ConfigVersion, error) {
+	data, appErr := config.Mattermost.KVGet(util.GetKeyHash(standupConfigHistoryKey(channelID, standupID)))
+	if appErr != nil {
+		logger.Error("Couldn't fetch standup config history from KV store", appErr, map[string]interface{}{"channelID": channelID})
+		return nil, errors.New(appErr.Error())
+	}
+
+	history := []*ConfigVersion{}
+	if len(data) == 0 {
+		return history, nil
+	}
+
+	if err := json.Unmarshal(data, &history); err != nil {
+		logger.Error("Couldn't unmarshal standup config history", err, map[string]interface{}{"channelID": channelID})
+		return nil, err
+	}
+
+	return history, nil
+}
+
+func (s *KVStore) SetStandupConfigHistory(channelID, standupID string, history []*ConfigVersion) error {
+	data, err := json.Marshal(history)
+	if err != nil {
+		logger.Error("Couldn't marshal standup config history", err, map[string]interface{}{"channelID": channelID})
+		return err
+	}
+
+	if appErr := config.Mattermost.KVSet(util.GetKeyHash(standupConfigHistoryKey(channelID, standupID)), data); appErr != nil {
+		logger.Error("Couldn't save standup config history in KV store", appErr, map[string]interface{}{"channelID": channelID})
+		return errors.New(appErr.Error())
+	}
+
+	return nil
+}
+
+func (s *KVStore) GetUserStandup(userID, channelID, standupID, date string) (*UserStandup, error) {
+	data, appErr := config.Mattermost.KVGet(util.GetKeyHash(userStandupKey(userID, channelID, standupID, date)))
+	if appErr != nil {
+		logger.Error("Couldn't fetch user standup from KV store", appErr, map[string]interface{}{"userID": userID, "channelID": channelID})
+		return nil, errors.New(appErr.Error())
+	}
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	userStandup := &UserStandup{}
+	if err := json.Unmarshal(data, userStandup); err != nil {
+		logger.Error("Couldn't unmarshal user standup data", err, nil)
+		return nil, err
+	}
+
+	return userStandup, nil
+}
+
+func (s *KVStore) SaveUserStandup(date string, userStandup *UserStandup) error {
+	data, err := json.Marshal(userStandup)
+	if err != nil {
+		logger.Error("Error occurred in serializing user standup", err, nil)
+		return err
+	}
+
+	if appErr := config.Mattermost.KVSet(util.GetKeyHash(userStandupKey(userStandup.UserID, userStandup.ChannelID, userStandup.StandupID, date)), data); appErr != nil {
+		logger.Error("Error occurred in saving user standup in KV store", errors.New(appErr.Error()), nil)
+		return errors.New(appErr.Error())
+	}
+
+	return nil
+}
+
+func (s *KVStore) DeleteUserStandup(userID, channelID, standupID, date string) (bool, error) {
+	return s.deleteIfExists(util.GetKeyHash(userStandupKey(userID, channelID, standupID, date)))
+}
+
+func (s *KVStore) GetNotificationStatus(channelID, standupID, date string) (*ChannelNotificationStatus, error) {
+	data, appErr := config.Mattermost.KVGet(util.GetKeyHash(notificationStatusKey(channelID, standupID, date)))
+	if appErr != nil {
+		logger.Error("Couldn't get notification status from KV store", appErr, nil)
+		return nil, errors.New(appErr.Error())
+	}
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	status := &ChannelNotificationStatus{}
+	if err := json.Unmarshal(data, status); err != nil {
+		logger.Error("Couldn't unmarshal notification status data into struct", err, map[string]interface{}{"channelID": channelID, "data": string(data)})
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func (s *KVStore) SetNotificationStatus(channelID, standupID, date string, status *ChannelNotificationStatus) error {
+	data, err := json.Marshal(status)
+	if err != nil {
+		logger.Error("Couldn't marshal standup status data", err, nil)
+		return err
+	}
+
+	if appErr := config.Mattermost.KVSet(util.GetKeyHash(notificationStatusKey(channelID, standupID, date)), data); appErr != nil {
+		logger.Error("Couldn't save standup status data into KV store", appErr, nil)
+		return errors.New(appErr.Error())
+	}
+
+	return nil
+}
+
+func (s *KVStore) DeleteNotificationStatus(channelID, standupID, date string) (bool, error) {
+	return s.deleteIfExists(util.GetKeyHash(notificationStatusKey(channelID, standupID, date)))
+}
+
+func (s *KVStore) GetLastProcessedOccurrence(channelID, standupID string) (string, error) {
+	data, appErr := config.Mattermost.KVGet(util.GetKeyHash(lastProcessedOccurrenceKey(channelID, standupID)))
+	if appErr != nil {
+		logger.Error("Couldn't get last processed standup occurrence from KV store", appErr, nil)
+		return "", errors.New(appErr.Error())
+	}
+
+	return string(data), nil
+}
+
+func (s *KVStore) SetLastProcessedOccurrence(channelID, standupID, date string) error {
+	if appErr := config.Mattermost.KVSet(util.GetKeyHash(lastProcessedOccurrenceKey(channelID, standupID)), []byte(date)); appErr != nil {
+		logger.Error("Couldn't save last processed standup occurrence into KV store", appErr, nil)
+		return errors.New(appErr.Error())
+	}
+
+	return nil
+}
+
+func (s *KVStore) GetReminderPosts(channelID, standupID string) ([]string, error) {
+	data, appErr := config.Mattermost.KVGet(util.GetKeyHash(reminderPostsKey(channelID, standupID)))
+	if appErr != nil {
+		logger.Error("Couldn't get standup reminder posts from KV store", appErr, nil)
+		return nil, errors.New(appErr.Error())
+	}
+
+	if len(data) == 0 {
+		return []string{}, nil
+	}
+
+	var reminderPosts []string
+	if err := json.Unmarshal(data, &reminderPosts); err != nil {
+		logger.Error("Couldn't unmarshal standup reminder posts", err, nil)
+		return nil, err
+	}
+
+	return reminderPosts, nil
+}
+
+func (s *KVStore) SetReminderPosts(channelID, standupID string, postIDs []string) error {
+	data, err := json.Marshal(postIDs)
+	if err != nil {
+		logger.Error("Couldn't marshal standup reminder posts", err, nil)
+		return err
+	}
+
+	if appErr := config.Mattermost.KVSet(util.GetKeyHash(reminderPostsKey(channelID, standupID)), data); appErr != nil {
+		logger.Error("Couldn't save standup reminder posts into KV store", appErr, nil)
+		return errors.New(appErr.Error())
+	}
+
+	return nil
+}
+
+func (s *KVStore) DeleteReminderPosts(channelID, standupID string) error {
+	if appErr := config.Mattermost.KVDelete(util.GetKeyHash(reminderPostsKey(channelID, standupID))); appErr != nil {
+		logger.Error("Couldn't delete standup reminder posts from KV store", appErr, nil)
+		return errors.New(appErr.Error())
+	}
+
+	return nil
+}
+
+func (s *KVStore) GetDataPurgeStatus() (map[string]string, error) {
+	data, appErr := config.Mattermost.KVGet(util.GetKeyHash(config.CacheKeyDataPurgeStatus))
+	if appErr != nil {
+		logger.Error("Couldn't fetch data purge status from KV store", appErr, nil)
+		return nil, errors.New(appErr.Error())
+	}
+
+	status := map[string]string{}
+	if len(data) == 0 {
+		return status, nil
+	}
+
+	if err := json.Unmarshal(data, &status); err != nil {
+		logger.Error("Couldn't unmarshal data purge status", err, map[string]interface{}{"data": string(data)})
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func (s *KVStore) SetDataPurgeStatus(status map[string]string) error {
+	data, err := json.Marshal(status)
+	if err != nil {
+		logger.Error("Couldn't marshal data purge status", err, nil)
+		return err
+	}
+
+	if appErr := config.Mattermost.KVSet(util.GetKeyHash(config.CacheKeyDataPurgeStatus), data); appErr != nil {
+		logger.Error("Couldn't save data purge status into KV store", appErr, nil)
+		return errors.New(appErr.Error())
+	}
+
+	return nil
+}
+
+func (s *KVStore) GetUserOutOfOffice(userID string) (*OutOfOffice, error) {
+	data, appErr := config.Mattermost.KVGet(util.GetKeyHash(userOutOfOfficeKey(userID)))
+	if appErr != nil {
+		logger.Error("Couldn't fetch user out of office period from KV store", appErr, map[string]interface{}{"userID": userID})
+		return nil, errors.New(appErr.Error())
+	}
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var outOfOffice *OutOfOffice
+	if err := json.Unmarshal(data, &outOfOffice); err != nil {
+		logger.Error("Couldn't unmarshal user out of office period", err, map[string]interface{}{"data": string(data)})
+		return nil, err
+	}
+
+	return outOfOffice, nil
+}
+
+// SetUserOutOfOffice saves the period with an expiry a couple of days after it ends,
+// once it has ended in every timezone, so ended periods don't pile up.
+func (s *KVStore) SetUserOutOfOffice(userID string, outOfOffice *OutOfOffice) error {
+	to, err := time.Parse(otime.LayoutISODate, outOfOffice.To)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(outOfOffice)
+	if err != nil {
+		logger.Error("Couldn't marshal user out of office period", err, nil)
+		return err
+	}
+
+	expiry := int64(to.AddDate(0, 0, outOfOfficeExpiryDays).Sub(otime.Now("UTC").Time).Seconds())
+	if expiry < 1 {
+		expiry = 1
+	}
+
+	if appErr := config.Mattermost.KVSetWithExpiry(util.GetKeyHash(userOutOfOfficeKey(userID)), data, expiry); appErr != nil {
+		logger.Error("Couldn't save user out of office period into KV store", appErr, map[string]interface{}{"userID": userID})
+		return errors.New(appErr.Error())
+	}
+
+	return nil
+}
+
+func (s *KVStore) DeleteUserOutOfOffice(userID string) (bool, error) {
+	return s.deleteIfExists(util.GetKeyHash(userOutOfOfficeKey(userID)))
+}
+
+// deleteIfExists deletes the key and reports whether it existed.
+// The plugin API doesn't report that on delete, hence the extra read.
+func (s *KVStore) deleteIfExists(key string) (bool, error) {
+	data, appErr := config.Mattermost.KVGet(key)
+	if appErr != nil {
+		logger.Error("Couldn't fetch data from KV store", appErr, map[string]interface{}{"key": key})
+		return false, errors.New(appErr.Error())
+	}
+
+	if data == nil {
+		return false, nil
+	}
+
+	if appErr := config.Mattermost.KVDelete(key); appErr != nil {
+		logger.Error("Couldn't delete data from KV store", appErr, map[string]interface{}{"key": key})
+		return false, errors.New(appErr.Error())
+	}
+
+	return true, nil
+}
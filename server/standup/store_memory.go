@@ -0,0 +1,255 @@
+package standup
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/standup-raven/standup-raven/server/config"
+)
+
+// MemoryStore is an in-memory Store, useful for running
+// the standup cycle without a Mattermost server.
+// Values are stored serialized so callers never share
+// data with the store.
+type MemoryStore struct {
+	mutex sync.RWMutex
+	data  map[string][]byte
+}
+
+// NewMemoryStore creates an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		data: map[string][]byte{},
+	}
+}
+
+// get unmarshals the value stored against the key into v.
+// Returns false if the key doesn't exist.
+func (s *MemoryStore) get(key string, v interface{}) (bool, error) {
+	s.mutex.RLock()
+	data, ok := s.data[key]
+	s.mutex.RUnlock()
+
+	if !ok {
+		return false, nil
+	}
+
+	return true, json.Unmarshal(data, v)
+}
+
+func (s *MemoryStore) set(key string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	s.data[key] = data
+	s.mutex.Unlock()
+	return nil
+}
+
+// delete removes the key and reports whether it existed.
+func (s *MemoryStore) delete(key string) bool {
+	s.mutex.Lock()
+	_, ok := s.data[key]
+	delete(s.data, key)
+	s.mutex.Unlock()
+	return ok
+}
+
+func (s *MemoryStore) GetSchemaVersion() (string, error) {
+	var version string
+	_, err := s.get(config.CacheKeyDatabaseSchemaVersion, &version)
+	return version, err
+}
+
+func (s *MemoryStore) SetSchemaVersion(version string) error {
+	return s.set(config.CacheKeyDatabaseSchemaVersion, version)
+}
+
+func (s *MemoryStore) GetStandupChannels() (map[string]string, error) {
+	channels := map[string]string{}
+	if _, err := s.get(config.CacheKeyAllStandupChannels, &channels); err != nil {
+		return nil, err
+	}
+
+	return channels, nil
+}
+
+func (s *MemoryStore) SetStandupChannels(channels map[string]string) error {
+	return s.set(config.CacheKeyAllStandupChannels, channels)
+}
+
+func (s *MemoryStore) GetStandupConfig(channelID, standupID string) (*Config, error) {
+	standupConfig := &Config{}
+	if ok, err := s.get(standupConfigKey(channelID, standupID), standupConfig); !ok || err != nil {
+		return nil, err
+	}
+
+	return standupConfig, nil
+}
+
+func (s *MemoryStore) SaveStandupConfig(standupConfig *Config) error {
+	return s.set(standupConfigKey(standupConfig.ChannelID, standupConfig.StandupID), standupConfig)
+}
+
+func (s *MemoryStore) ArchiveStandupConfig(channelID string) error {
+	key := standupConfigKey(channelID, DefaultStandupID)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if data, ok := s.data[key]; ok {
+		s.data[key+archivedKeySuffix] = data
+		delete(s.data, key)
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) GetArchivedStandupConfig(channelID string) (*Config, error) {
+	archivedConfig := &Config{}
+	if ok, err := s.get(standupConfigKey(channelID, DefaultStandupID)+archivedKeySuffix, archivedConfig); !ok || err != nil {
+		return nil, err
+	}
+
+	return archivedConfig, nil
+}
+
+func (s *MemoryStore) GetArchivedStandupConfigs() ([]*Config, error) {
+	s.mutex.RLock()
+	keys := []string{}
+	for key := range s.data {
+		if strings.HasPrefix(key, config.CacheKeyPrefixTeamStandupConfig) && strings.HasSuffix(key, archivedKeySuffix) {
+			keys = append(keys, key)
+		}
+	}
+	s.mutex.RUnlock()
+
+	archivedConfigs := []*Config{}
+	for _, key := range keys {
+		archivedConfig := &Config{}
+		if ok, err := s.get(key, archivedConfig); err != nil {
+			return nil, err
+		} else if ok {
+			archivedConfigs = append(archivedConfigs, archivedConfig)
+		}
+	}
+
+	return archivedConfigs, nil
+}
+
+func (s *MemoryStore) DeleteArchivedStandupConfig(channelID string) error {
+	s.delete(standupConfigKey(channelID, DefaultStandupID) + archivedKeySuffix)
+	return nil
+}
+
+func (s *MemoryStore) GetStandupConfigHistory(channelID, standupID string) ([]*ConfigVersion, error) {
+	history := []*ConfigVersion{}
+	if _, err := s.get(standupConfigHistoryKey(channelID, standupID), &history); err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+func (s *MemoryStore) SetStandupConfigHistory(channelID, standupID string, history []*ConfigVersion) error {
+	return s.set(standupConfigHistoryKey(channelID, standupID), history)
+}
+
+func (s *MemoryStore) GetUserStandup(userID, channelID, standupID, date string) (*UserStandup, error) {
+	userStandup := &UserStandup{}
+	if ok, err := s.get(userStandupKey(userID, channelID, standupID, date), userStandup); !ok || err != nil {
+		return nil, err
+	}
+
+	return userStandup, nil
+}
+
+func (s *MemoryStore) SaveUserStandup(date string, userStandup *UserStandup) error {
+	return s.set(userStandupKey(userStandup.UserID, userStandup.ChannelID, userStandup.StandupID, date), userStandup)
+}
+
+func (s *MemoryStore) DeleteUserStandup(userID, channelID, standupID, date string) (bool, error) {
+	return s.delete(userStandupKey(userID, channelID, standupID, date)), nil
+}
+
+func (s *MemoryStore) GetNotificationStatus(channelID, standupID, date string) (*ChannelNotificationStatus, error) {
+	status := &ChannelNotificationStatus{}
+	if ok, err := s.get(notificationStatusKey(channelID, standupID, date), status); !ok || err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func (s *MemoryStore) SetNotificationStatus(channelID, standupID, date string, status *ChannelNotificationStatus) error {
+	return s.set(notificationStatusKey(channelID, standupID, date), status)
+}
+
+func (s *MemoryStore) DeleteNotificationStatus(channelID, standupID, date string) (bool, error) {
+	return s.delete(notificationStatusKey(channelID, standupID, date)), nil
+}
+
+func (s *MemoryStore) GetLastProcessedOccurrence(channelID, standupID string) (string, error) {
+	date := ""
+	if _, err := s.get(lastProcessedOccurrenceKey(channelID, standupID), &date); err != nil {
+		return "", err
+	}
+
+	return date, nil
+}
+
+func (s *MemoryStore) SetLastProcessedOccurrence(channelID, standupID, date string) error {
+	return s.set(lastProcessedOccurrenceKey(channelID, standupID), date)
+}
+
+func (s *MemoryStore) GetReminderPosts(channelID, standupID string) ([]string, error) {
+	reminderPosts := []string{}
+	if _, err := s.get(reminderPostsKey(channelID, standupID), &reminderPosts); err != nil {
+		return nil, err
+	}
+
+	return reminderPosts, nil
+}
+
+func (s *MemoryStore) SetReminderPosts(channelID, standupID string, postIDs []string) error {
+	return s.set(reminderPostsKey(channelID, standupID), postIDs)
+}
+
+func (s *MemoryStore) DeleteReminderPosts(channelID, standupID string) error {
+	s.delete(reminderPostsKey(channelID, standupID))
+	return nil
+}
+
+func (s *MemoryStore) GetDataPurgeStatus() (map[string]string, error) {
+	status := map[string]string{}
+	if _, err := s.get(config.CacheKeyDataPurgeStatus, &status); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func (s *MemoryStore) SetDataPurgeStatus(status map[string]string) error {
+	return s.set(config.CacheKeyDataPurgeStatus, status)
+}
+
+func (s *MemoryStore) GetUserOutOfOffice(userID string) (*OutOfOffice, error) {
+	var outOfOffice *OutOfOffice
+	if _, err := s.get(userOutOfOfficeKey(userID), &outOfOffice); err != nil {
+		return nil, err
+	}
+
+	return outOfOffice, nil
+}
+
+func (s *MemoryStore) SetUserOutOfOffice(userID string, outOfOffice *OutOfOffice) error {
+	return s.set(userOutOfOfficeKey(userID), outOfOffice)
+}
+
+func (s *MemoryStore) DeleteUserOutOfOffice(userID string) (bool, error) {
+	return s.delete(userOutOfOfficeKey(userID)), nil
+}
@@ -0,0 +1,77 @@
+package standup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/standup-raven/standup-raven/server/config"
+)
+
+func TestMemoryStore(t *testing.T) {
+	s := NewMemoryStore()
+
+	version, err := s.GetSchemaVersion()
+	assert.Nil(t, err)
+	assert.Equal(t, "", version)
+	assert.Nil(t, s.SetSchemaVersion("3.3.2"))
+	version, _ = s.GetSchemaVersion()
+	assert.Equal(t, "3.3.2", version)
+
+	channels, err := s.GetStandupChannels()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{}, channels)
+	assert.Nil(t, s.SetStandupChannels(map[string]string{"channel_1": "channel_1"}))
+	channels, _ = s.GetStandupChannels()
+	assert.Equal(t, map[string]string{"channel_1": "channel_1"}, channels)
+
+	standupConfig, err := s.GetStandupConfig("channel_1", "")
+	assert.Nil(t, err)
+	assert.Nil(t, standupConfig)
+	assert.Nil(t, s.SaveStandupConfig(&Config{
+		ChannelID:    "channel_1",
+		Sections:     []string{"section_1"},
+		ReportFormat: config.ReportFormatUserAggregated,
+	}))
+	standupConfig, _ = s.GetStandupConfig("channel_1", "")
+	assert.Equal(t, []string{"section_1"}, standupConfig.Sections)
+
+	// stored data must not be shared with callers
+	standupConfig.Sections[0] = "modified"
+	standupConfig, _ = s.GetStandupConfig("channel_1", "")
+	assert.Equal(t, "section_1", standupConfig.Sections[0])
+
+	assert.Nil(t, s.ArchiveStandupConfig("channel_1"))
+	standupConfig, _ = s.GetStandupConfig("channel_1", "")
+	assert.Nil(t, standupConfig)
+
+	userStandup, err := s.GetUserStandup("user_1", "channel_1", "", "20200701")
+	assert.Nil(t, err)
+	assert.Nil(t, userStandup)
+	assert.Nil(t, s.SaveUserStandup("20200701", &UserStandup{
+		UserID:    "user_1",
+		ChannelID: "channel_1",
+		Standup:   map[string]*[]string{"section_1": {"task_1"}},
+	}))
+	userStandup, _ = s.GetUserStandup("user_1", "channel_1", "", "20200701")
+	assert.Equal(t, []string{"task_1"}, *userStandup.Standup["section_1"])
+	userStandup, _ = s.GetUserStandup("user_1", "channel_1", "", "20200702")
+	assert.Nil(t, userStandup)
+
+	status, err := s.GetNotificationStatus("channel_1", "", "20200701")
+	assert.Nil(t, err)
+	assert.Nil(t, status)
+	assert.Nil(t, s.SetNotificationStatus("channel_1", "", "20200701", &ChannelNotificationStatus{StandupReportSent: true}))
+	status, _ = s.GetNotificationStatus("channel_1", "", "20200701")
+	assert.True(t, status.StandupReportSent)
+
+	posts, err := s.GetReminderPosts("channel_1", "")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{}, posts)
+	assert.Nil(t, s.SetReminderPosts("channel_1", "", []string{"post_1"}))
+	posts, _ = s.GetReminderPosts("channel_1", "")
+	assert.Equal(t, []string{"post_1"}, posts)
+	assert.Nil(t, s.DeleteReminderPosts("channel_1", ""))
+	posts, _ = s.GetReminderPosts("channel_1", "")
+	assert.Equal(t, []string{}, posts)
+}
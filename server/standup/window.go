@@ -0,0 +1,51 @@
+package standup
+
+import (
+	"time"
+
+	"github.com/standup-raven/standup-raven/server/otime"
+)
+
+// CrossesMidnight checks if standup window closes on the day after it opens,
+// such as a window from 22:00 to 02:00.
+func (sc *Config) CrossesMidnight() bool {
+	return secondOfDay(sc.WindowOpenTime.Time) > secondOfDay(sc.WindowCloseTime.Time)
+}
+
+// Window returns open and close times of the standup window opening on the
+// calendar date of the specified time, in the location of that time.
+func (sc *Config) Window(date time.Time) (time.Time, time.Time) {
+	windowOpen := sc.WindowOpenTime.On(date)
+	windowClose := sc.WindowCloseTime.On(date)
+
+	if sc.CrossesMidnight() {
+		windowClose = windowClose.AddDate(0, 0, 1)
+	}
+
+	return windowOpen, windowClose
+}
+
+// StandupDate returns the date of the standup the specified time belongs to,
+// as midnight in the location of the time. For windows crossing midnight this is
+// the day the window last opened, so standups submitted and reports sent after midnight
+// are attributed to the previous day. Otherwise it's the calendar date of the time.
+func (sc *Config) StandupDate(t time.Time) time.Time {
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+
+	if sc.CrossesMidnight() {
+		if windowOpen, _ := sc.Window(t); t.Before(windowOpen) {
+			date = date.AddDate(0, 0, -1)
+		}
+	}
+
+	return date
+}
+
+// CurrentStandupDate returns the date of the standup current time belongs to in standup timezone.
+func (sc *Config) CurrentStandupDate() otime.OTime {
+	return otime.OTime{Time: sc.StandupDate(otime.Now(sc.Timezone).Time)}
+}
+
+func secondOfDay(t time.Time) int {
+	return t.Hour()*3600 + t.Minute()*60 + t.Second()
+}
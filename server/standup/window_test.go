@@ -0,0 +1,92 @@
+package standup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/standup-raven/standup-raven/server/otime"
+)
+
+func TestConfig_Window(t *testing.T) {
+	location, _ := time.LoadLocation("Asia/Kolkata")
+
+	windowOpenTime, _ := otime.Parse("10:00")
+	windowCloseTime, _ := otime.Parse("11:00")
+	standupConfig := &Config{
+		WindowOpenTime:  windowOpenTime,
+		WindowCloseTime: windowCloseTime,
+	}
+
+	assert.False(t, standupConfig.CrossesMidnight())
+
+	windowOpen, windowClose := standupConfig.Window(time.Date(2020, 1, 6, 15, 0, 0, 0, location))
+	assert.Equal(t, time.Date(2020, 1, 6, 10, 0, 0, 0, location), windowOpen)
+	assert.Equal(t, time.Date(2020, 1, 6, 11, 0, 0, 0, location), windowClose)
+
+	assert.Equal(t, time.Date(2020, 1, 6, 0, 0, 0, 0, location), standupConfig.StandupDate(time.Date(2020, 1, 6, 1, 0, 0, 0, location)))
+	assert.Equal(t, time.Date(2020, 1, 6, 0, 0, 0, 0, location), standupConfig.StandupDate(time.Date(2020, 1, 6, 23, 0, 0, 0, location)))
+
+	standupConfig.WindowOpenTime, _ = otime.Parse("22:00")
+	standupConfig.WindowCloseTime, _ = otime.Parse("02:00")
+
+	assert.True(t, standupConfig.CrossesMidnight())
+
+	windowOpen, windowClose = standupConfig.Window(time.Date(2020, 1, 6, 0, 0, 0, 0, location))
+	assert.Equal(t, time.Date(2020, 1, 6, 22, 0, 0, 0, location), windowOpen)
+	assert.Equal(t, time.Date(2020, 1, 7, 2, 0, 0, 0, location), windowClose)
+
+	assert.Equal(t, time.Date(2020, 1, 6, 0, 0, 0, 0, location), standupConfig.StandupDate(time.Date(2020, 1, 6, 22, 0, 0, 0, location)))
+	assert.Equal(t, time.Date(2020, 1, 6, 0, 0, 0, 0, location), standupConfig.StandupDate(time.Date(2020, 1, 7, 1, 0, 0, 0, location)))
+	assert.Equal(t, time.Date(2020, 1, 6, 0, 0, 0, 0, location), standupConfig.StandupDate(time.Date(2020, 1, 7, 21, 59, 0, 0, location)))
+	assert.Equal(t, time.Date(2020, 1, 7, 0, 0, 0, 0, location), standupConfig.StandupDate(time.Date(2020, 1, 7, 22, 0, 0, 0, location)))
+
+	// window crossing midnight on the night clocks are put forward
+	newYork, _ := time.LoadLocation("America/New_York")
+	standupConfig.WindowOpenTime, _ = otime.Parse("23:00")
+	standupConfig.WindowCloseTime, _ = otime.Parse("03:00")
+	windowOpen, windowClose = standupConfig.Window(time.Date(2020, 3, 7, 0, 0, 0, 0, newYork))
+	assert.Equal(t, 3*time.Hour, windowClose.Sub(windowOpen))
+}
+
+func TestConfig_Window_DaylightSaving(t *testing.T) {
+	newYork, _ := time.LoadLocation("America/New_York")
+	windowOpenTime, _ := otime.Parse("09:00")
+	windowCloseTime, _ := otime.Parse("10:00")
+	standupConfig := &Config{
+		WindowOpenTime:  windowOpenTime,
+		WindowCloseTime: windowCloseTime,
+	}
+
+	// window times stay the same wall-clock time on either side of a transition
+	for _, day := range []int{7, 8, 9} {
+		windowOpen, windowClose := standupConfig.Window(time.Date(2020, 3, day, 0, 0, 0, 0, newYork))
+		assert.Equal(t, time.Date(2020, 3, day, 9, 0, 0, 0, newYork), windowOpen)
+		assert.Equal(t, time.Date(2020, 3, day, 10, 0, 0, 0, newYork), windowClose)
+	}
+
+	windowOpen, _ := standupConfig.Window(time.Date(2020, 3, 7, 0, 0, 0, 0, newYork))
+	assert.Equal(t, 14, windowOpen.UTC().Hour())
+	windowOpen, _ = standupConfig.Window(time.Date(2020, 3, 8, 0, 0, 0, 0, newYork))
+	assert.Equal(t, 13, windowOpen.UTC().Hour())
+
+	// window times are resolved in the location of the date
+	kolkata, _ := time.LoadLocation("Asia/Kolkata")
+	windowOpen, _ = standupConfig.Window(time.Date(2020, 3, 8, 0, 0, 0, 0, kolkata))
+	assert.Equal(t, time.Date(2020, 3, 8, 9, 0, 0, 0, kolkata), windowOpen)
+
+	// windows crossing midnight on the nights clocks are put forward and back
+	standupConfig.WindowOpenTime, _ = otime.Parse("23:00")
+	standupConfig.WindowCloseTime, _ = otime.Parse("03:00")
+
+	windowOpen, windowClose := standupConfig.Window(time.Date(2020, 3, 7, 0, 0, 0, 0, newYork))
+	assert.Equal(t, time.Date(2020, 3, 8, 3, 0, 0, 0, newYork), windowClose)
+	assert.Equal(t, 3*time.Hour, windowClose.Sub(windowOpen))
+	assert.Equal(t, time.Date(2020, 3, 7, 0, 0, 0, 0, newYork), standupConfig.StandupDate(time.Date(2020, 3, 8, 1, 30, 0, 0, newYork)))
+
+	windowOpen, windowClose = standupConfig.Window(time.Date(2020, 10, 31, 0, 0, 0, 0, newYork))
+	assert.Equal(t, time.Date(2020, 11, 1, 3, 0, 0, 0, newYork), windowClose)
+	assert.Equal(t, 5*time.Hour, windowClose.Sub(windowOpen))
+	assert.Equal(t, time.Date(2020, 10, 31, 0, 0, 0, 0, newYork), standupConfig.StandupDate(time.Date(2020, 11, 1, 1, 30, 0, 0, newYork)))
+}
@@ -13,6 +13,10 @@ func ParseRRuleFromString(rruleString string, startDate time.Time) (*rrule.RRule
 		return nil, err
 	}
 
+	// rules without days specified default to
+	// days of start date, so it needs to be set beforehand
+	rruleOptions.Dtstart = startDate
+
 	rule, err := rrule.NewRRule(*rruleOptions)
 	if err != nil {
 		return nil, err
@@ -6,7 +6,6 @@ import (
 	"testing"
 	"time"
 
-	"bou.ke/monkey"
 	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/stretchr/testify/assert"
 
@@ -71,13 +70,8 @@ func TestDifference(t *testing.T) {
 }
 
 func TestGetCurrentDateString(t *testing.T) {
-	monkey.Patch(otime.Now, func(timezone string) otime.OTime {
-		t, _ := time.Parse("02-Jan-06", "02-Jan-06")
-		return otime.OTime{
-			Time: t,
-		}
-	})
-	defer monkey.Unpatch(otime.Now)
+	otime.SetClock(otime.NewFakeClock(time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)))
+	defer otime.SetClock(otime.SystemClock)
 
 	assert.Equal(t, "20060102", GetCurrentDateString("Asia/Kolkata"))
 }
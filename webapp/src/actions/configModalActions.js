@@ -1,9 +1,10 @@
 import Constants from '../constants';
 
-export const openConfigModal = (channelId) => (dispatch) => {
+export const openConfigModal = (channelId, standupId = '') => (dispatch) => {
     dispatch({
         type: Constants.ACTIONS.OPEN_CONFIG_MODAL,
         channelId,
+        standupId,
     });
 };
 
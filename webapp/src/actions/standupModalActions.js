@@ -1,9 +1,10 @@
 import Constants from '../constants';
 
-export const openStandupModal = (channelId) => (dispatch) => {
+export const openStandupModal = (channelId, standupId = '') => (dispatch) => {
     dispatch({
         type: Constants.ACTIONS.OPEN_STANDUP_MODAL,
         channelId,
+        standupId,
     });
 };
 
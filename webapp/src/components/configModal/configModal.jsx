@@ -54,6 +54,7 @@ class ConfigModal extends (SentryBoundary, React.Component) {
         return {
             user_aggregated: 'User Aggregated',
             type_aggregated: 'Type Aggregated',
+            custom: 'Custom',
         };
     }
 
@@ -92,11 +93,22 @@ class ConfigModal extends (SentryBoundary, React.Component) {
             },
             windowOpenReminderEnabled: true,
             windowCloseReminderEnabled: true,
+            reminders: '',
+            directMessageReminders: false,
+            windowOpenMessage: '',
+            windowCloseMessage: '',
+            reportTemplate: '',
+            updateReportPost: false,
+            reportChannels: [],
+            reportUsers: [],
             timezone: '',
+            memberTimezonesEnabled: false,
             scheduleEnabled: false,
             schedule: '',
             rruleString: '',
             startDate: new Date().toISOString(),
+            exDates: [],
+            rDates: [],
             pluginConfig: {
                 permissionSchemaEnabled: true,
             },
@@ -126,6 +138,18 @@ class ConfigModal extends (SentryBoundary, React.Component) {
         });
     };
 
+    handleReportTemplateChange = (e) => {
+        this.setState({
+            reportTemplate: e.target.value,
+        });
+    };
+
+    handleUpdateReportPostChange = () => {
+        this.setState({
+            updateReportPost: !this.state.updateReportPost,
+        });
+    };
+
     handleStatusChange = () => {
         this.setState({
             enabled: !this.state.enabled,
@@ -136,18 +160,48 @@ class ConfigModal extends (SentryBoundary, React.Component) {
         this.setState({timezone});
     };
 
+    handleMemberTimezonesChange = () => {
+        this.setState({
+            memberTimezonesEnabled: !this.state.memberTimezonesEnabled,
+        });
+    };
+
     handleWindowCloseReminderChange = () => {
         this.setState({
             windowCloseReminderEnabled: !this.state.windowCloseReminderEnabled,
         });
     };
 
+    handleDirectMessageRemindersChange = () => {
+        this.setState({
+            directMessageReminders: !this.state.directMessageReminders,
+        });
+    };
+
     handleWindowOpenReminderChange = () => {
         this.setState({
             windowOpenReminderEnabled: !this.state.windowOpenReminderEnabled,
         });
     };
 
+    handleRemindersChange = (e) => {
+        this.setState({
+            reminders: e.target.value,
+        });
+    };
+
+    handleWindowOpenMessageChange = (e) => {
+        this.setState({
+            windowOpenMessage: e.target.value,
+        });
+    };
+
+    handleWindowCloseMessageChange = (e) => {
+        this.setState({
+            windowCloseMessage: e.target.value,
+        });
+    };
+
     handleScheduleStatusChange = () => {
         this.setState({
             scheduleEnabled: !this.state.scheduleEnabled,
@@ -211,7 +265,7 @@ class ConfigModal extends (SentryBoundary, React.Component) {
 
     getStandupConfig = () => {
         return new Promise((resolve) => {
-            const url = `${this.props.siteURL}/${Constants.URL_STANDUP_CONFIG}?channel_id=${this.props.channelID}`;
+            const url = `${this.props.siteURL}/${Constants.URL_STANDUP_CONFIG}?channel_id=${this.props.channelID}&standup_id=${encodeURIComponent(this.props.standupID)}`;
             request
                 .get(url)
                 .withCredentials()
@@ -227,17 +281,28 @@ class ConfigModal extends (SentryBoundary, React.Component) {
                             prevState.windowOpenTime = standupConfig.windowOpenTime;
                             prevState.windowCloseTime = standupConfig.windowCloseTime;
                             prevState.reportFormat = standupConfig.reportFormat;
+                            prevState.reportTemplate = standupConfig.reportTemplate || '';
+                            prevState.updateReportPost = standupConfig.updateReportPost;
+                            prevState.reportChannels = standupConfig.reportChannels || [];
+                            prevState.reportUsers = standupConfig.reportUsers || [];
                             prevState.members = standupConfig.members;
                             prevState.sections = {};
                             prevState.enabled = standupConfig.enabled;
                             prevState.status = standupConfig.enabled;
                             prevState.timezone = standupConfig.timezone;
+                            prevState.memberTimezonesEnabled = standupConfig.memberTimezonesEnabled;
                             prevState.windowOpenReminderEnabled = standupConfig.windowOpenReminderEnabled;
                             prevState.windowCloseReminderEnabled = standupConfig.windowCloseReminderEnabled;
+                            prevState.reminders = utils.formatReminders(standupConfig.reminders);
+                            prevState.directMessageReminders = standupConfig.directMessageReminders;
+                            prevState.windowOpenMessage = standupConfig.windowOpenMessage || '';
+                            prevState.windowCloseMessage = standupConfig.windowCloseMessage || '';
                             prevState.scheduleEnabled = standupConfig.scheduleEnabled;
                             prevState.schedule = standupConfig.schedule;
                             prevState.rruleString = standupConfig.rruleString;
                             prevState.startDate = standupConfig.startDate;
+                            prevState.exDates = standupConfig.exDates || [];
+                            prevState.rDates = standupConfig.rDates || [];
                             prevState.isEffectiveChannelAdmin = utils.isEffectiveChannelAdmin(this.props.userRoles);
                             prevState.sections = sections;
                             prevState.standupConfigured = true;
@@ -295,24 +360,47 @@ class ConfigModal extends (SentryBoundary, React.Component) {
     prepareStandupConfigPayload() {
         return {
             channelId: this.props.channelID,
+            standupId: this.props.standupID,
             windowOpenTime: this.state.windowOpenTime,
             windowCloseTime: this.state.windowCloseTime,
             reportFormat: this.state.reportFormat,
+            reportTemplate: this.state.reportTemplate,
+            updateReportPost: this.state.updateReportPost,
+            reportChannels: this.state.reportChannels,
+            reportUsers: this.state.reportUsers,
             sections: Object.values(this.state.sections).map((x) => x.trim()).filter((x) => x !== ''),
             members: this.state.members,
             enabled: this.state.enabled,
             timezone: this.state.timezone,
+            memberTimezonesEnabled: this.state.memberTimezonesEnabled,
             windowCloseReminderEnabled: this.state.windowCloseReminderEnabled,
             windowOpenReminderEnabled: this.state.windowOpenReminderEnabled,
+            reminders: utils.parseReminders(this.state.reminders),
+            directMessageReminders: this.state.directMessageReminders,
+            windowOpenMessage: this.state.windowOpenMessage,
+            windowCloseMessage: this.state.windowCloseMessage,
             scheduleEnabled: this.state.scheduleEnabled,
             rruleString: this.state.rruleString,
             startDate: this.state.startDate,
+            exDates: this.state.exDates,
+            rDates: this.state.rDates,
         };
     }
 
     saveStandupConfig = (e) => {
         e.preventDefault();
 
+        if (utils.parseReminders(this.state.reminders) === null) {
+            this.setState({
+                message: {
+                    show: true,
+                    text: 'Invalid reminder schedule. Use reminders like "open+30, close-15".',
+                    type: 'danger',
+                },
+            });
+            return;
+        }
+
         // hiding message section so animation can re-trigger on new message
         this.setState({
             message: {
@@ -321,7 +409,7 @@ class ConfigModal extends (SentryBoundary, React.Component) {
         });
 
         request
-            .post(`${this.props.siteURL}/${Constants.URL_STANDUP_CONFIG}?channel_id=${this.props.channelID}`)
+            .post(`${this.props.siteURL}/${Constants.URL_STANDUP_CONFIG}?channel_id=${this.props.channelID}&standup_id=${encodeURIComponent(this.props.standupID)}`)
             .withCredentials()
             .send(this.prepareStandupConfigPayload())
             .set('X-CSRF-Token', Cookies.get(Constants.MATTERMOST_CSRF_COOKIE))
@@ -453,8 +541,38 @@ class ConfigModal extends (SentryBoundary, React.Component) {
                                     >
                                         <MenuItem eventKey={'user_aggregated'}>{'User Aggregated'}</MenuItem>
                                         <MenuItem eventKey={'type_aggregated'}>{'Type Aggregated'}</MenuItem>
+                                        <MenuItem eventKey={'custom'}>{'Custom'}</MenuItem>
                                     </SplitButton>
                                 </FormGroup>
+                                {this.state.reportFormat === 'custom' && (
+                                    <FormGroup
+                                        style={style.formGroup}
+                                        disabled={!this.state.hasPermission}
+                                    >
+                                        <ControlLabel style={style.controlLabel}>
+                                            {'Report Template:'}
+                                        </ControlLabel>
+                                        <FormControl
+                                            componentClass={'textarea'}
+                                            rows={6}
+                                            value={this.state.reportTemplate}
+                                            onChange={this.handleReportTemplateChange}
+                                        />
+                                    </FormGroup>
+                                )}
+                                <FormGroup
+                                    style={style.formGroup}
+                                    disabled={!this.state.hasPermission}
+                                >
+                                    <ControlLabel style={style.controlLabel}>
+                                        {'Update Report With Late Standups:'}
+                                    </ControlLabel>
+                                    <ToggleSwitch
+                                        onChange={this.handleUpdateReportPostChange}
+                                        checked={this.state.updateReportPost}
+                                        theme={this.props.theme}
+                                    />
+                                </FormGroup>
                                 <FormGroup
                                     style={{...style.formGroup, ...style.formGroupNoMarginBottom}}
                                     disabled={!this.state.hasPermission}
@@ -496,6 +614,61 @@ class ConfigModal extends (SentryBoundary, React.Component) {
                                         theme={this.props.theme}
                                     />
                                 </FormGroup>
+                                <FormGroup
+                                    style={style.formGroup}
+                                    disabled={!this.state.hasPermission}
+                                >
+                                    <ControlLabel style={style.controlLabel}>
+                                        {'Reminder Schedule:'}
+                                    </ControlLabel>
+                                    <FormControl
+                                        type={'text'}
+                                        placeholder={'Default'}
+                                        value={this.state.reminders}
+                                        onChange={this.handleRemindersChange}
+                                    />
+                                </FormGroup>
+                                <FormGroup
+                                    style={style.formGroup}
+                                    disabled={!this.state.hasPermission}
+                                >
+                                    <ControlLabel style={style.controlLabel}>
+                                        {'Direct Message Reminders:'}
+                                    </ControlLabel>
+                                    <ToggleSwitch
+                                        onChange={this.handleDirectMessageRemindersChange}
+                                        checked={this.state.directMessageReminders}
+                                        theme={this.props.theme}
+                                    />
+                                </FormGroup>
+                                <FormGroup
+                                    style={style.formGroup}
+                                    disabled={!this.state.hasPermission}
+                                >
+                                    <ControlLabel style={style.controlLabel}>
+                                        {'Window Open Message:'}
+                                    </ControlLabel>
+                                    <FormControl
+                                        componentClass={'textarea'}
+                                        placeholder={'Default'}
+                                        value={this.state.windowOpenMessage}
+                                        onChange={this.handleWindowOpenMessageChange}
+                                    />
+                                </FormGroup>
+                                <FormGroup
+                                    style={style.formGroup}
+                                    disabled={!this.state.hasPermission}
+                                >
+                                    <ControlLabel style={style.controlLabel}>
+                                        {'Window Close Message:'}
+                                    </ControlLabel>
+                                    <FormControl
+                                        componentClass={'textarea'}
+                                        placeholder={'Default'}
+                                        value={this.state.windowCloseMessage}
+                                        onChange={this.handleWindowCloseMessageChange}
+                                    />
+                                </FormGroup>
                             </Tab>
                             <Tab
                                 eventKey={3}
@@ -537,6 +710,19 @@ class ConfigModal extends (SentryBoundary, React.Component) {
                                     >{data}
                                     </SplitButton>
                                 </FormGroup>
+                                <FormGroup
+                                    style={style.formGroup}
+                                    disabled={!this.state.hasPermission}
+                                >
+                                    <ControlLabel style={style.controlLabel}>
+                                        {'Member Timezones:'}
+                                    </ControlLabel>
+                                    <ToggleSwitch
+                                        onChange={this.handleMemberTimezonesChange}
+                                        checked={this.state.memberTimezonesEnabled}
+                                        theme={this.props.theme}
+                                    />
+                                </FormGroup>
                                 <FormGroup disabled={!this.state.hasPermission}>
                                     <RRule
                                         startDate={this.state.startDate}
@@ -590,6 +776,7 @@ class ConfigModal extends (SentryBoundary, React.Component) {
 
 ConfigModal.propTypes = {
     channelID: PropTypes.string.isRequired,
+    standupID: PropTypes.string,
     currentUserId: PropTypes.string.isRequired,
     userRoles: PropTypes.arrayOf(PropTypes.string).isRequired,
     close: PropTypes.func.isRequired,
@@ -12,6 +12,7 @@ const mapStateToProps = (state) => {
         currentUserId: state.entities.users.currentUserId,
         userRoles: util.getCurrentUserRoles(state),
         channelID: state.entities.channels.currentChannelId,
+        standupID: Selectors.getConfigModalStandupID(state),
         visible: Selectors.isConfigModalVisible(state),
         siteURL: util.trimTrailingSlash(util.getValueSafely(state, 'entities.general.config.SiteURL', '')),
         isGuest: util.isGuestUser(state, state.entities.users.currentUserId),
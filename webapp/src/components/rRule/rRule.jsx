@@ -24,7 +24,7 @@ class RRule extends React.PureComponent {
     }
 
     static get frequencies() {
-        return ['Monthly', 'Weekly'];
+        return ['Yearly', 'Monthly', 'Weekly', 'Daily'];
     }
 
     static getInitialState = () => {
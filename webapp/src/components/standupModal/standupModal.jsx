@@ -75,7 +75,7 @@ class StandupModal extends (SentryBoundary, React.Component) {
         const payload = this.prepareUserStandup();
 
         request
-            .post(`${this.props.siteURL}/${Constants.URL_SUBMIT_USER_STANDUP}?channel_id=${this.props.channelID}`)
+            .post(`${this.props.siteURL}/${Constants.URL_SUBMIT_USER_STANDUP}?channel_id=${this.props.channelID}&standup_id=${encodeURIComponent(this.props.standupID)}`)
             .withCredentials()
             .send(payload)
             .set('X-CSRF-Token', Cookies.get(Constants.MATTERMOST_CSRF_COOKIE))
@@ -105,7 +105,7 @@ class StandupModal extends (SentryBoundary, React.Component) {
     getUserStandup = () => {
         return new Promise((resolve) => {
             request
-                .get(`${this.props.siteURL}/${Constants.URL_SUBMIT_USER_STANDUP}?channel_id=${this.props.channelID}`)
+                .get(`${this.props.siteURL}/${Constants.URL_SUBMIT_USER_STANDUP}?channel_id=${this.props.channelID}&standup_id=${encodeURIComponent(this.props.standupID)}`)
                 .withCredentials()
                 .end((err, result) => {
                     if (result.ok) {
@@ -126,7 +126,7 @@ class StandupModal extends (SentryBoundary, React.Component) {
 
     getStandupConfig = () => {
         return new Promise((resolve) => {
-            const url = `${this.props.siteURL}/${Constants.URL_STANDUP_CONFIG}?channel_id=${this.props.channelID}`;
+            const url = `${this.props.siteURL}/${Constants.URL_STANDUP_CONFIG}?channel_id=${this.props.channelID}&standup_id=${encodeURIComponent(this.props.standupID)}`;
             request
                 .get(url)
                 .withCredentials()
@@ -153,6 +153,7 @@ class StandupModal extends (SentryBoundary, React.Component) {
     prepareUserStandup = () => {
         const standup = {
             channelId: this.props.channelID,
+            standupId: this.props.standupID,
             standup: {},
         };
 
@@ -356,6 +357,7 @@ class StandupModal extends (SentryBoundary, React.Component) {
 
 StandupModal.propTypes = {
     channelID: PropTypes.string.isRequired,
+    standupID: PropTypes.string,
     currentUserId: PropTypes.string.isRequired,
     close: PropTypes.func.isRequired,
     visible: PropTypes.bool.isRequired,
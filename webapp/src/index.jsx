@@ -30,14 +30,14 @@ class StandupRavenPlugin {
         registry.registerRootComponent(ConfigModal);
         registry.registerWebSocketEventHandler(
             `custom_${Constants.PLUGIN_NAME}_open_config_modal`,
-            () => {
-                store.dispatch(Actions.openConfigModal());
+            (event) => {
+                store.dispatch(Actions.openConfigModal(event.data.channel_id, event.data.standup_id));
             },
         );
         registry.registerWebSocketEventHandler(
             `custom_${Constants.PLUGIN_NAME}_open_standup_modal`,
-            () => {
-                store.dispatch(Actions.openStandupModal());
+            (event) => {
+                store.dispatch(Actions.openStandupModal(event.data.channel_id, event.data.standup_id));
             },
         );
 
@@ -14,3 +14,15 @@ export const configModalVisible = (state = false, action) => {
             return prevState || false;
     }
 };
+
+let prevStandupID;
+
+export const configModalStandupID = (state = '', action) => {
+    switch (action.type) {
+        case Constants.ACTIONS.OPEN_CONFIG_MODAL:
+            prevStandupID = action.standupId || '';
+            return prevStandupID;
+        default:
+            return prevStandupID || '';
+    }
+};
@@ -1,11 +1,13 @@
 import {combineReducers} from 'redux';
-import {standupModalVisible} from './standupModalReducer';
-import {configModalVisible} from './configModalReducer';
+import {standupModalVisible, standupModalStandupID} from './standupModalReducer';
+import {configModalVisible, configModalStandupID} from './configModalReducer';
 import {addedActiveChannel, removedActiveChannel} from './activeChannel';
 
 export default combineReducers({
     standupModalVisible,
     configModalVisible,
+    standupModalStandupID,
+    configModalStandupID,
     addedActiveChannel,
     removedActiveChannel,
 });
@@ -14,3 +14,15 @@ export const standupModalVisible = (state = false, action) => {
             return prevState || false;
     }
 };
+
+let prevStandupID;
+
+export const standupModalStandupID = (state = '', action) => {
+    switch (action.type) {
+        case Constants.ACTIONS.OPEN_STANDUP_MODAL:
+            prevStandupID = action.standupId || '';
+            return prevStandupID;
+        default:
+            return prevStandupID || '';
+    }
+};
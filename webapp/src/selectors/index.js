@@ -6,6 +6,10 @@ export const isStandupModalVisible = (state) => getPluginState(state).standupMod
 
 export const isConfigModalVisible = (state) => getPluginState(state).configModalVisible || false;
 
+export const getStandupModalStandupID = (state) => getPluginState(state).standupModalStandupID || '';
+
+export const getConfigModalStandupID = (state) => getPluginState(state).configModalStandupID || '';
+
 export const addedActiveChannel = (state) => getPluginState(state).addedActiveChannel || '';
 
 export const removedActiveChannel = (state) => getPluginState(state).removedActiveChannel || '';
@@ -13,6 +17,8 @@ export const removedActiveChannel = (state) => getPluginState(state).removedActi
 export default {
     isStandupModalVisible,
     isConfigModalVisible,
+    getStandupModalStandupID,
+    getConfigModalStandupID,
     addedActiveChannel,
     removedActiveChannel,
 };
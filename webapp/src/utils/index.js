@@ -67,6 +67,43 @@ function isGuestUser(state, userID) {
     return state.entities.users.profiles[userID] ? state.entities.users.profiles[userID].roles.indexOf(systemGuestRole) > -1 : false;
 }
 
+/**
+ * Formats standup reminders as a comma separated list
+ * like "open+30, close-15".
+ *
+ * @param reminders list of reminders with anchor and minutes.
+ * @return {string} formatted reminders
+ */
+function formatReminders(reminders) {
+    return (reminders || []).map((reminder) => {
+        const sign = reminder.anchor === 'close' ? '-' : '+';
+        return `${reminder.anchor}${sign}${reminder.minutes}`;
+    }).join(', ');
+}
+
+/**
+ * Parses standup reminders formatted by formatReminders.
+ *
+ * @param value comma separated reminders.
+ * @return {Array} parsed reminders, or null if any reminder is invalid
+ */
+function parseReminders(value) {
+    const reminders = [];
+    for (const entry of value.split(',').map((x) => x.trim()).filter((x) => x !== '')) {
+        const match = (/^(open)\s*\+\s*(\d+)$|^(close)\s*-\s*(\d+)$/).exec(entry);
+        if (!match) {
+            return null;
+        }
+
+        reminders.push({
+            anchor: match[1] || match[3],
+            minutes: parseInt(match[2] || match[4], 10),
+        });
+    }
+
+    return reminders;
+}
+
 export default {
     getBaseURL,
     getValueSafely,
@@ -77,4 +114,6 @@ export default {
     systemAdminRole,
     getCurrentUserRoles,
     isGuestUser,
+    formatReminders,
+    parseReminders,
 };